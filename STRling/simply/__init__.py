@@ -3,3 +3,4 @@ from .constructors import *
 from .lookarounds import *
 from .sets import *
 from .static import *
+from .prebuilt import *
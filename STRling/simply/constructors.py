@@ -1,5 +1,5 @@
 
-from .pattern import STRlingError, Pattern, lit
+from .pattern import STRlingError, Pattern, lit, _is_escaped, _has_range, _has_mode
 
 
 
@@ -369,6 +369,15 @@ def group(name, *patterns):
         """
         raise STRlingError(message)
 
+    if not name.isidentifier():
+        message = f"""
+        Method: simply.group(name, *patterns)
+
+        The group name '{name}' is invalid.
+        The `name` must start with a letter or underscore and contain only letters, digits, and underscores.
+        """
+        raise STRlingError(message)
+
 
     # Check all patterns are instance of Pattern or str
     clean_patterns = []
@@ -415,7 +424,648 @@ def group(name, *patterns):
 
     sub_names = named_group_counts.keys()
 
+    if name in sub_names:
+        message = f"""
+        Method: simply.group(name, *patterns)
+
+        Named groups must be unique.
+        The group name '{name}' is already used by a group inside it.
+
+        Rename one of the groups, or change the inner group to `simply.merge()` if you don't need later reference.
+        """
+        raise STRlingError(message)
+
     joined = ''.join(str(p) for p in clean_patterns)
     new_pattern = f'(?P<{name}>{joined})'
 
     return Pattern(new_pattern, composite=True, named_groups=[name, *sub_names])
+
+def atomic(*patterns):
+    """
+    Combines the provided patterns into one group that never gives back characters once it has matched.
+
+    - Atomic groups require Python 3.11 or later.
+
+    Example: simply as s
+        - Matches one or more letters followed by a 'b', without backtracking into the letters.
+
+        my_pattern = s.merge(s.atomic(s.letter(1, 0)), 'b')
+
+        In the text, "aab" the pattern above fails since the letters already took the 'b'.
+
+    Parameters:
+    - *patterns (Pattern/str): One or more patterns to be grouped atomically.
+
+    Returns:
+    - Pattern: A Pattern object representing the atomic group of the given patterns.
+    """
+
+    # Check all patterns are instance of Pattern or str
+    clean_patterns = []
+    for pattern in patterns:
+        if isinstance(pattern, str):
+            pattern = lit(pattern)
+
+        if not isinstance(pattern, Pattern):
+            message = """
+            Method: simply.atomic(*patterns)
+
+            The parameters must be instances of `Pattern` or `str`.
+
+            Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+            """
+            raise STRlingError(message)
+
+        clean_patterns.append(pattern)
+
+
+    # Count named groups and raise error if not unique
+    named_group_counts = {}
+
+    for pattern in clean_patterns:
+        for group_name in pattern.named_groups:
+            if group_name in named_group_counts:
+                named_group_counts[group_name] += 1
+            else:
+                named_group_counts[group_name] = 1
+
+    duplicates = {name: count for name, count in named_group_counts.items() if count > 1}
+    if duplicates:
+        duplicate_info = ", ".join([f"{name}: {count}" for name, count in duplicates.items()])
+        message = f"""
+        Method: simply.atomic(*patterns)
+
+        Named groups must be unique.
+        Duplicate named groups found: {duplicate_info}.
+
+        If you need later reference change the named group argument to `simply.capture()`.
+        If you don't need later reference change the named group argument to `simply.merge()`.
+        """
+        raise STRlingError(message)
+
+    sub_names = named_group_counts.keys()
+
+    joined = ''.join(str(p) for p in clean_patterns)
+    new_pattern = f'(?>{joined})'
+
+    return Pattern(new_pattern, composite=True, named_groups=sub_names)
+
+def case_insensitive(*patterns):
+    """
+    Combines the provided patterns into one pattern that matches letters of either case.
+
+    Example: simply as s
+        - Matches 'abc' in any case followed by 'DEF' in uppercase only.
+
+        my_pattern = s.merge(s.case_insensitive('abc'), 'DEF')
+
+    Parameters:
+    - *patterns (Pattern/str): One or more patterns to be matched case insensitively.
+
+    Returns:
+    - Pattern: A Pattern object representing the case insensitively match of the given patterns.
+    """
+
+    # Check all patterns are instance of Pattern or str
+    clean_patterns = []
+    for pattern in patterns:
+        if isinstance(pattern, str):
+            pattern = lit(pattern)
+
+        if not isinstance(pattern, Pattern):
+            message = """
+            Method: simply.case_insensitive(*patterns)
+
+            The parameters must be instances of `Pattern` or `str`.
+
+            Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+            """
+            raise STRlingError(message)
+
+        clean_patterns.append(pattern)
+
+
+    # Count named groups and raise error if not unique
+    named_group_counts = {}
+
+    for pattern in clean_patterns:
+        for group_name in pattern.named_groups:
+            if group_name in named_group_counts:
+                named_group_counts[group_name] += 1
+            else:
+                named_group_counts[group_name] = 1
+
+    duplicates = {name: count for name, count in named_group_counts.items() if count > 1}
+    if duplicates:
+        duplicate_info = ", ".join([f"{name}: {count}" for name, count in duplicates.items()])
+        message = f"""
+        Method: simply.case_insensitive(*patterns)
+
+        Named groups must be unique.
+        Duplicate named groups found: {duplicate_info}.
+
+        If you need later reference change the named group argument to `simply.capture()`.
+        If you don't need later reference change the named group argument to `simply.merge()`.
+        """
+        raise STRlingError(message)
+
+    sub_names = named_group_counts.keys()
+
+    joined = ''.join(str(p) for p in clean_patterns)
+    new_pattern = f'(?i:{joined})'
+
+    return Pattern(new_pattern, composite=True, named_groups=sub_names)
+
+def case_sensitive(*patterns):
+    """
+    Combines the provided patterns into one pattern that matches letters only in the case given, even inside `simply.case_insensitive()` or with the `re.IGNORECASE` flag.
+
+    Example: simply as s
+        - Matches 'abc' in any case followed by 'DEF' in uppercase only.
+
+        my_pattern = s.case_insensitive('abc', s.case_sensitive('DEF'))
+
+    Parameters:
+    - *patterns (Pattern/str): One or more patterns to be matched case sensitively.
+
+    Returns:
+    - Pattern: A Pattern object representing the case sensitively match of the given patterns.
+    """
+
+    # Check all patterns are instance of Pattern or str
+    clean_patterns = []
+    for pattern in patterns:
+        if isinstance(pattern, str):
+            pattern = lit(pattern)
+
+        if not isinstance(pattern, Pattern):
+            message = """
+            Method: simply.case_sensitive(*patterns)
+
+            The parameters must be instances of `Pattern` or `str`.
+
+            Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+            """
+            raise STRlingError(message)
+
+        clean_patterns.append(pattern)
+
+
+    # Count named groups and raise error if not unique
+    named_group_counts = {}
+
+    for pattern in clean_patterns:
+        for group_name in pattern.named_groups:
+            if group_name in named_group_counts:
+                named_group_counts[group_name] += 1
+            else:
+                named_group_counts[group_name] = 1
+
+    duplicates = {name: count for name, count in named_group_counts.items() if count > 1}
+    if duplicates:
+        duplicate_info = ", ".join([f"{name}: {count}" for name, count in duplicates.items()])
+        message = f"""
+        Method: simply.case_sensitive(*patterns)
+
+        Named groups must be unique.
+        Duplicate named groups found: {duplicate_info}.
+
+        If you need later reference change the named group argument to `simply.capture()`.
+        If you don't need later reference change the named group argument to `simply.merge()`.
+        """
+        raise STRlingError(message)
+
+    sub_names = named_group_counts.keys()
+
+    joined = ''.join(str(p) for p in clean_patterns)
+    new_pattern = f'(?-i:{joined})'
+
+    return Pattern(new_pattern, composite=True, named_groups=sub_names)
+
+def separated_by(item, separator, allow_trailing: bool = False):
+    """
+    Matches one or more of the item pattern with the separator pattern between each.
+
+    Example: simply as s
+        - Matches a comma separated list of numbers such as '1,22,333'.
+
+        numbers = s.separated_by(s.digit(1, 0), ',')
+
+        - Also matches a trailing comma such as '1,22,333,'.
+
+        numbers = s.separated_by(s.digit(1, 0), ',', allow_trailing=True)
+
+    Parameters:
+    - item (Pattern/str): The pattern matched for each item in the list.
+    - separator (Pattern/str): The pattern matched between each item.
+    - allow_trailing (optional): If True, the list may end with a separator. Defaults to False.
+
+    Returns:
+    - Pattern: A Pattern object representing the separated list.
+    """
+
+    # Check all patterns are instance of Pattern or str
+    clean_patterns = []
+    for pattern in (item, separator):
+        if isinstance(pattern, str):
+            pattern = lit(pattern)
+
+        if not isinstance(pattern, Pattern):
+            message = """
+            Method: simply.separated_by(item, separator)
+
+            The `item` and `separator` must be instances of `Pattern` or `str`.
+
+            Use a string such as "," to match literal characters, or use a predefined set like `simply.whitespace()`.
+            """
+            raise STRlingError(message)
+
+        clean_patterns.append(pattern)
+
+    item, separator = clean_patterns
+
+    if not isinstance(allow_trailing, bool):
+        message = """
+        Method: simply.separated_by(item, separator, allow_trailing)
+
+        The `allow_trailing` argument must be a boolean (True or False).
+        """
+        raise STRlingError(message)
+
+    # The item and separator repeat, so they cannot contain named groups
+    if item.named_groups or separator.named_groups:
+        message = """
+        Method: simply.separated_by(item, separator)
+
+        Named groups cannot be repeated as they must be unique.
+
+        Consider using an unlabeled group (merge) or a numbered group (capture).
+        """
+        raise STRlingError(message)
+
+    new_pattern = merge(item, merge(separator, item)(0, 0))
+    if allow_trailing:
+        new_pattern = merge(new_pattern, may(separator))
+
+    return new_pattern
+
+def _group_spans(pattern: str):
+    # Maps the index of each unescaped '(' to its matching ')', skipping parentheses inside sets.
+    spans, opened, in_set = {}, [], False
+    for i, char in enumerate(pattern):
+        if _is_escaped(pattern, i):
+            continue
+        if in_set:
+            in_set = char != ']'
+        elif char == '[':
+            in_set = True
+        elif char == '(':
+            opened.append(i)
+        elif char == ')' and opened:
+            spans[opened.pop()] = i
+    return spans
+
+def _unwrap(pattern: str, open_index: int, close_index: int):
+    # Returns the inside of a non-capturing group, or None if anchors inside it don't anchor the whole group.
+    if pattern[open_index:open_index + 3] != '(?:' or pattern[close_index + 1:close_index + 2] in ('{', '?', '*', '+'):
+        return None
+    inner = pattern[open_index + 3:close_index]
+    depth, in_set = 0, False
+    for i, char in enumerate(inner):
+        if _is_escaped(inner, i):
+            continue
+        if in_set:
+            in_set = char != ']'
+        elif char == '[':
+            in_set = True
+        elif char == '(':
+            depth += 1
+        elif char == ')':
+            depth -= 1
+        elif char == '|' and depth == 0:
+            return None
+    return inner
+
+def _starts_with_anchor(pattern: str, anchor: str):
+    if pattern.startswith(anchor):
+        return True
+    close_index = _group_spans(pattern).get(0)
+    if close_index is None:
+        return False
+    inner = _unwrap(pattern, 0, close_index)
+    return inner is not None and _starts_with_anchor(inner, anchor)
+
+def _ends_with_anchor(pattern: str, anchor: str):
+    anchor_index = len(pattern) - len(anchor)
+    if pattern.endswith(anchor) and not _is_escaped(pattern, anchor_index):
+        return True
+    if not pattern.endswith(')') or _is_escaped(pattern, len(pattern) - 1):
+        return False
+    open_index = next((i for i, j in _group_spans(pattern).items() if j == len(pattern) - 1), None)
+    if open_index is None:
+        return False
+    inner = _unwrap(pattern, open_index, len(pattern) - 1)
+    return inner is not None and _ends_with_anchor(inner, anchor)
+
+def anchored(pattern):
+    """
+    Requires the provided pattern to match an entire line, from `simply.start()` to `simply.end()`.
+
+    - Anchors the pattern already has are not added again.
+
+    Example: simply as s
+        - Only matches text that is exactly 3 digits.
+
+        my_pattern = s.anchored(s.digit(3))
+
+        In the text, "1234" the pattern above doesn't match, but in "123" it does.
+
+    Parameters:
+    - pattern (Pattern/str): The pattern to anchor.
+
+    Returns:
+    - Pattern: A Pattern object representing the anchored pattern.
+    """
+
+    if isinstance(pattern, str):
+        pattern = lit(pattern)
+
+    if not isinstance(pattern, Pattern):
+        message = """
+        Method: simply.anchored(pattern)
+
+        The parameter must be an instance of `Pattern` or `str`.
+
+        Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+        """
+        raise STRlingError(message)
+
+    new_pattern = str(pattern)
+    if not _starts_with_anchor(new_pattern, '^'):
+        new_pattern = f'^{new_pattern}'
+    if not _ends_with_anchor(new_pattern, '$'):
+        new_pattern = f'{new_pattern}$'
+
+    return Pattern(new_pattern, composite=True, named_groups=pattern.named_groups)
+
+def template(text, **patterns):
+    """
+    Matches the literal text with each {name} placeholder replaced by the provided pattern of that name.
+
+    - Use '{{' and '}}' to match literal braces.
+    - Every placeholder must be provided and every provided pattern must be used.
+
+    Example: simply as s
+        - Matches order numbers such as 'order-123456-2024-05-31'.
+
+        order = s.template('order-{id}-{date}', id=s.digit(6), date=s.iso_date())
+
+    Parameters:
+    - text (str): The literal text containing {name} placeholders.
+    - **patterns (Pattern/str): The pattern to use for each placeholder name.
+
+    Returns:
+    - Pattern: A Pattern object representing the text with the placeholders filled in.
+    """
+
+    if not isinstance(text, str):
+        message = """
+        Method: simply.template(text, **patterns)
+
+        The `text` parameter must be a string like 'order-{id}'.
+        """
+        raise STRlingError(message)
+
+    for name, pattern in patterns.items():
+        if not isinstance(pattern, (Pattern, str)):
+            message = f"""
+            Method: simply.template(text, **patterns)
+
+            The pattern provided for '{name}' must be an instance of `Pattern` or `str`.
+
+            Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+            """
+            raise STRlingError(message)
+
+    # Split the text into literal strings and placeholder patterns
+    parts = []
+    used_names = set()
+    literal = ''
+    i = 0
+    while i < len(text):
+        if text[i:i + 2] in ('{{', '}}'):
+            literal += text[i]
+            i += 2
+        elif text[i] == '{':
+            close = text.find('}', i)
+            name = text[i + 1:close]
+            if close == -1 or not name.isidentifier():
+                message = f"""
+                Method: simply.template(text, **patterns)
+
+                The placeholder at position {i} must be a name in braces like '{{id}}'.
+
+                Use '{{{{' and '}}}}' to match literal braces.
+                """
+                raise STRlingError(message)
+
+            if name not in patterns:
+                message = f"""
+                Method: simply.template(text, **patterns)
+
+                The placeholder '{name}' at position {i} was not provided a pattern.
+
+                Provide it as a keyword argument, for example simply.template(text, {name}=simply.digit()).
+                """
+                raise STRlingError(message)
+
+            if literal:
+                parts.append(literal)
+                literal = ''
+            parts.append(patterns[name])
+            used_names.add(name)
+            i = close + 1
+        elif text[i] == '}':
+            message = f"""
+            Method: simply.template(text, **patterns)
+
+            The closing brace at position {i} has no opening brace.
+
+            Use '}}}}' to match a literal closing brace.
+            """
+            raise STRlingError(message)
+        else:
+            literal += text[i]
+            i += 1
+
+    if literal:
+        parts.append(literal)
+
+    unused = [name for name in patterns if name not in used_names]
+    if unused:
+        message = f"""
+        Method: simply.template(text, **patterns)
+
+        The provided patterns have no matching placeholder: {", ".join(unused)}.
+
+        Add a placeholder like '{{{unused[0]}}}' to the text or remove the argument.
+        """
+        raise STRlingError(message)
+
+    return merge(*parts)
+
+def fully_anchored(pattern):
+    """
+    Requires the provided pattern to match the entire text, from `simply.absolute_start()` to `simply.absolute_end()`.
+
+    - Unlike `simply.anchored()`, this can't match a single line of multiline text or before a final newline.
+    - Anchors the pattern already has are not added again.
+
+    Example: simply as s
+        - Only matches text that is exactly 3 digits, even with the `re.MULTILINE` flag.
+
+        my_pattern = s.fully_anchored(s.digit(3))
+
+        In the text, "123\\n" the pattern above doesn't match.
+
+    Parameters:
+    - pattern (Pattern/str): The pattern to anchor.
+
+    Returns:
+    - Pattern: A Pattern object representing the fully anchored pattern.
+    """
+
+    if isinstance(pattern, str):
+        pattern = lit(pattern)
+
+    if not isinstance(pattern, Pattern):
+        message = """
+        Method: simply.fully_anchored(pattern)
+
+        The parameter must be an instance of `Pattern` or `str`.
+
+        Use a string such as "123abc$" to match literal characters, or use a predefined set like `simply.letter()`.
+        """
+        raise STRlingError(message)
+
+    new_pattern = str(pattern)
+    if not _starts_with_anchor(new_pattern, '\\A'):
+        new_pattern = f'\\A{new_pattern}'
+    if not _ends_with_anchor(new_pattern, '\\Z'):
+        new_pattern = f'{new_pattern}\\Z'
+
+    return Pattern(new_pattern, composite=True, named_groups=pattern.named_groups)
+
+
+
+############################
+# Repetition Modes
+########
+
+
+def lazy(pattern):
+    """
+    Makes a repeated pattern match as few characters as possible rather than as many as possible.
+
+    - The pattern MUST already be invoked with a range or made optional with `simply.may()`.
+
+    s.lazy(s.letter()) <== INVALID
+
+    Example: simply as s
+        - Matches the shortest text between two quotes.
+
+        quoted = s.merge('"', s.lazy(s.not_newline(0, 0)), '"')
+
+        In the text, '"a" and "b"' the pattern above matches '"a"' and '"b"' rather than the whole text.
+
+    Parameters:
+    - pattern (Pattern): A pattern invoked with a range or made optional with `simply.may()`.
+
+    Returns:
+    - Pattern: A Pattern object representing the lazy repetition of the given pattern.
+    """
+
+    if not isinstance(pattern, Pattern):
+        message = """
+        Method: simply.lazy(pattern)
+
+        The parameter must be an instance of `Pattern`.
+
+        Use a pattern invoked with a range such as `simply.letter(1, 3)`.
+        """
+        raise STRlingError(message)
+
+    if not _has_range(pattern.pattern):
+        message = """
+        Method: simply.lazy(pattern)
+
+        The pattern must be invoked with a range or made optional with `simply.may()` before it can be made lazy.
+
+        Example: simply.lazy(simply.letter(1, 3))
+        """
+        raise STRlingError(message)
+
+    if _has_mode(pattern.pattern):
+        message = """
+        Method: simply.lazy(pattern)
+
+        The pattern is already lazy or possessive and can only have one repetition mode.
+
+        Apply `simply.lazy()` or `simply.possessive()` once to a pattern that has a range.
+        """
+        raise STRlingError(message)
+
+    return Pattern(f'{pattern}?', composite=True, named_groups=pattern.named_groups)
+
+def possessive(pattern):
+    """
+    Makes a repeated pattern match as many characters as possible without ever giving any back.
+
+    - The pattern MUST already be invoked with a range or made optional with `simply.may()`.
+    - Possessive repetition requires Python 3.11 or later.
+
+    s.possessive(s.letter()) <== INVALID
+
+    Example: simply as s
+        - Matches digits but never backtracks into them.
+
+        my_pattern = s.merge(s.possessive(s.digit(1, 0)), s.letter())
+
+    Parameters:
+    - pattern (Pattern): A pattern invoked with a range or made optional with `simply.may()`.
+
+    Returns:
+    - Pattern: A Pattern object representing the possessive repetition of the given pattern.
+    """
+
+    if not isinstance(pattern, Pattern):
+        message = """
+        Method: simply.possessive(pattern)
+
+        The parameter must be an instance of `Pattern`.
+
+        Use a pattern invoked with a range such as `simply.letter(1, 3)`.
+        """
+        raise STRlingError(message)
+
+    if not _has_range(pattern.pattern):
+        message = """
+        Method: simply.possessive(pattern)
+
+        The pattern must be invoked with a range or made optional with `simply.may()` before it can be made possessive.
+
+        Example: simply.possessive(simply.letter(1, 3))
+        """
+        raise STRlingError(message)
+
+    if _has_mode(pattern.pattern):
+        message = """
+        Method: simply.possessive(pattern)
+
+        The pattern is already lazy or possessive and can only have one repetition mode.
+
+        Apply `simply.lazy()` or `simply.possessive()` once to a pattern that has a range.
+        """
+        raise STRlingError(message)
+
+    return Pattern(f'{pattern}+', composite=True, named_groups=pattern.named_groups)
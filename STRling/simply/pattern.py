@@ -1,5 +1,5 @@
 
-import re, textwrap
+import re, sys, textwrap
 
 
 
@@ -15,8 +15,19 @@ class STRlingError(ValueError):
     def __str__(self):
         return f"\n\nSTRlingError: Invalid Pattern Attempted.\n\n\t{self.message}"
 
+# Control characters are spelled out as escapes so the pattern never contains them raw.
+# The '/' delimiter is escaped too so patterns can be pasted between slashes.
+char_escapes = {'\n': r'\n', '\r': r'\r', '\t': r'\t', '\f': r'\f', '\v': r'\v', '/': r'\/'}
+
+def _escape_char(char):
+    if char in char_escapes:
+        return char_escapes[char]
+    if ord(char) < 0x20 or 0x7f <= ord(char) <= 0x9f:
+        return f'\\x{ord(char):02x}'
+    return re.escape(char)
+
 def lit(text):
-    escaped_text = re.escape(text).replace('/', '\/')
+    escaped_text = ''.join(_escape_char(char) for char in text)
     return Pattern(escaped_text)
 
 def repeat(min_rep: int = None, max_rep: int = None):
@@ -38,6 +49,34 @@ def repeat(min_rep: int = None, max_rep: int = None):
     else:
         return ''
 
+def _is_escaped(pattern: str, index: int):
+    backslashes = len(pattern[:index]) - len(pattern[:index].rstrip('\\'))
+    return backslashes % 2 == 1
+
+def _has_range(pattern: str):
+    # A range ends with an unescaped '}', or the '?' added by `simply.may()`.
+    # Lazy '?' and possessive '+' modes follow either one.
+    if not pattern or _is_escaped(pattern, len(pattern) - 1):
+        return False
+    if pattern[-1] in ('}', '?'):
+        return True
+    return pattern[-1] == '+' and _has_range(pattern[:-1])
+
+def _has_mode(pattern: str):
+    # A lazy or possessive mode is a '?' or '+' after a range.
+    return pattern[-1:] in ('?', '+') and _has_range(pattern[:-1])
+
+def _has_atomic_or_possessive(pattern: str):
+    # Looks for an unescaped '(?>' or a '+' after an unescaped quantifier.
+    for i in range(len(pattern)):
+        if _is_escaped(pattern, i):
+            continue
+        if pattern[i] == '(' and pattern[i + 1:i + 3] == '?>':
+            return True
+        if pattern[i] == '+' and i > 0 and pattern[i - 1] in '}?*+' and not _is_escaped(pattern, i - 1):
+            return True
+    return False
+
 class Pattern:
     """
     A class to construct and compile clean and manageable regex expressions.
@@ -52,6 +91,10 @@ class Pattern:
         - __call__(min_rep=None, max_rep=None): Returns a new Pattern object with the repetition pattern applied.
         - __str__(): Returns the pattern as a string.
         - __add__(other): Allows addition of two Pattern objects.
+        - __or__(other): Allows either of two Pattern objects to match.
+        - compile(flags=0): Returns the pattern compiled by Python's `re` module.
+        - matches(text), find(text), find_groups(text): Search the text without compiling by hand.
+        - capture_count(), group_names(): Describe the capturing groups in the pattern.
     """
     def __init__(self, pattern: str, custom_set: bool = False, negated: bool = False, composite: bool = False, named_groups: list = [], numbered_group: bool = False):
         # The regex pattern string for this instance.
@@ -66,6 +109,8 @@ class Pattern:
         self.named_groups = named_groups
         # A numbered_group is one that is copied rather than repeated
         self.numbered_group = numbered_group
+        # Compiled regex objects, cached by flags so repeated matching doesn't recompile.
+        self.compiled = {}
 
     def __call__(self, min_rep: int = None, max_rep: int = None):
         """
@@ -116,7 +161,7 @@ class Pattern:
             raise STRlingError(message)
 
         # A group already assigned a specified range cannot be reassigned
-        if len(self.pattern) > 1 and self.pattern[-1] == '}' and self.pattern[-2] != '\\':
+        if _has_range(self.pattern):
             message = """
             Method: Pattern.__call__(min_rep, max_rep)
 
@@ -165,6 +210,168 @@ class Pattern:
         """
         return self.pattern
 
+    def __add__(self, other):
+        """
+        Combines this pattern with another pattern or string, the same as `simply.merge(self, other)`.
+
+        Example: simply as s
+            my_pattern = s.letter() + s.digit(3) + '!'
+        """
+        from .constructors import merge
+        return merge(self, other)
+
+    def __radd__(self, other):
+        """
+        Combines a string with this pattern, the same as `simply.merge(other, self)`.
+        """
+        from .constructors import merge
+        return merge(other, self)
+
+    def __or__(self, other):
+        """
+        Matches either this pattern or another pattern or string, the same as `simply.any_of(self, other)`.
+
+        Note: As in Python, `+` is applied before `|`.
+        For example, a + b | c matches either `a` followed by `b`, or `c`.
+
+        Example: simply as s
+            my_pattern = s.digit(3) | s.letter(3)
+        """
+        from .constructors import any_of
+        return any_of(self, other)
+
+    def __ror__(self, other):
+        """
+        Matches either a string or this pattern, the same as `simply.any_of(other, self)`.
+        """
+        from .constructors import any_of
+        return any_of(other, self)
+
+    def compile(self, flags: int = 0):
+        """
+        Compiles the pattern into a Python `re.Pattern` object.
+
+        Example: simply as s
+            compiled = s.digit(3).compile()
+            compiled.search("abc123")
+
+            compiled = s.lit('abc').compile(re.IGNORECASE)
+            compiled.search("ABC")
+
+        Parameters:
+        - flags (optional): Any `re` flags such as `re.IGNORECASE | re.MULTILINE`.
+
+        Returns:
+        - A compiled `re.Pattern` object, the same as `re.compile(str(pattern), flags)`.
+        """
+        if not isinstance(flags, int):
+            message = """
+            Method: Pattern.compile(flags)
+
+            The `flags` argument must be `re` flags such as `re.IGNORECASE`.
+
+            Combine several flags with `|`, for example `re.IGNORECASE | re.MULTILINE`.
+            """
+            raise STRlingError(message)
+
+        if flags in self.compiled:
+            return self.compiled[flags]
+
+        try:
+            self.compiled[flags] = re.compile(self.pattern, flags)
+            return self.compiled[flags]
+        except re.error as error:
+            message = f"""
+            Method: Pattern.compile(flags)
+
+            The pattern could not be compiled by Python's `re` module: {error}.
+            """
+            # Only older versions of Python reject these, so only then is the hint relevant.
+            if sys.version_info < (3, 11) and _has_atomic_or_possessive(self.pattern):
+                message += """
+            Atomic groups and possessive repetition require Python 3.11 or later.
+            """
+            raise STRlingError(message)
+
+    def matches(self, text: str, flags: int = 0):
+        """
+        Checks whether the pattern is found anywhere in the text.
+
+        Example: simply as s
+            s.digit(3).matches("abc123")  # True
+
+        Parameters:
+        - text (str): The text to search.
+        - flags (optional): Any `re` flags such as `re.IGNORECASE`.
+
+        Returns:
+        - bool: True if the pattern is found in the text, otherwise False.
+        """
+        return self.compile(flags).search(text) is not None
+
+    def find(self, text: str, flags: int = 0):
+        """
+        Returns the first part of the text matched by the pattern.
+
+        Example: simply as s
+            s.digit(3).find("abc123def456")  # '123'
+
+        Parameters:
+        - text (str): The text to search.
+        - flags (optional): Any `re` flags such as `re.IGNORECASE`.
+
+        Returns:
+        - str: The first match, or None if the pattern is not found.
+        """
+        match = self.compile(flags).search(text)
+        return match.group() if match else None
+
+    def find_groups(self, text: str, flags: int = 0):
+        """
+        Returns the named groups of the first part of the text matched by the pattern.
+
+        Example: simply as s
+            s.merge(s.group('area_code', s.digit(3)), '-', s.group('last_part', s.digit(4))).find_groups("Call 555-1234")
+            # {'area_code': '555', 'last_part': '1234'}
+
+        Parameters:
+        - text (str): The text to search.
+        - flags (optional): Any `re` flags such as `re.IGNORECASE`.
+
+        Returns:
+        - dict: The named groups of the first match, or None if the pattern is not found.
+        """
+        match = self.compile(flags).search(text)
+        return match.groupdict() if match else None
+
+    def capture_count(self):
+        """
+        Returns the number of capturing groups in the pattern, both numbered and named.
+
+        Example: simply as s
+            s.merge(s.capture(s.digit()), s.group('word', s.letter(1, 0))).capture_count()  # 2
+
+        Returns:
+        - int: The number of capturing groups.
+        """
+        return self.compile().groups
+
+    def group_names(self):
+        """
+        Returns the name of each capturing group in the order they are numbered, with '' for numbered groups.
+
+        Example: simply as s
+            s.merge(s.capture(s.digit()), s.group('word', s.letter(1, 0))).group_names()  # ['', 'word']
+
+        Returns:
+        - list: The group names, where index 0 is group 1 of a match.
+        """
+        compiled = self.compile()
+        names = [''] * compiled.groups
+        for name, index in compiled.groupindex.items():
+            names[index - 1] = name
+        return names
+
     @classmethod
     def create_modified_instance(cls, new_pattern, **kwargs):
         """
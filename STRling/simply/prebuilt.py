@@ -0,0 +1,575 @@
+
+from .pattern import STRlingError, lit
+from .constructors import any_of, may, merge, group, separated_by
+from .lookarounds import ahead, not_ahead, behind, not_behind
+from .sets import between, in_chars, not_in_chars, chars
+from .static import letter, digit, hex_digit, word_char, whitespace, not_whitespace, start, end
+
+
+
+############################
+# Prebuilt Patterns
+########
+
+
+def email():
+    """
+    Matches a practical email address such as 'first.last+tag@mail.example.com'.
+
+    - The local part is word characters, plus signs, and hyphens, separated by single dots.
+    - The domain is one or more labels of letters, digits, and hyphens followed by a top level domain of 2 or more letters.
+    - The address is not matched inside a longer invalid one, so 'jane..doe@example.com' does not match 'doe@example.com'.
+
+    Example: simply as s
+        match = re.fullmatch(str(s.email()), "jane.doe@example.com")
+
+        print("Local:", match.group("local"))
+        print("Domain:", match.group("domain"))
+
+        # Output:
+        # Local: jane.doe
+        # Domain: example.com
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `local` and `domain`.
+    """
+
+    local_chunk = in_chars(word_char(), '+-')(1, 0)
+    local_part = merge(local_chunk, merge('.', local_chunk)(0, 0))
+
+    alphanumeric = in_chars(letter(), digit())
+    label = merge(alphanumeric, may(in_chars(alphanumeric, '-')(0, 0), alphanumeric))
+    domain_part = merge(merge(label, '.')(1, 0), letter(2, 0))
+
+    # The address is not matched when it is part of a longer run of address characters.
+    return merge(
+        not_behind(in_chars(word_char(), '+-.')),
+        group('local', local_part), '@', group('domain', domain_part),
+        not_ahead(any_of(in_chars(alphanumeric, '-'), merge('.', alphanumeric)))
+    )
+
+def url(require_scheme: bool = True, anchored: bool = False):
+    """
+    Matches an http or https URL such as 'https://example.com:8080/path?query=1#top'.
+
+    - The host is a domain name, 'localhost', or a dotted IP address.
+    - When the scheme is not required, the host must contain a dot, so 'localhost' only matches with a scheme.
+    - The port is 0 to 65535 without leading zeros, and a URL with any other port does not match.
+    - Trailing punctuation like a period or closing parenthesis is not included in the match.
+
+    Example: simply as s
+        match = re.search(str(s.url()), "Visit https://example.com:8080/docs?page=2.")
+
+        print("Full Match:", match.group())
+        print("Scheme:", match.group("scheme"))
+        print("Host:", match.group("host"))
+        print("Port:", match.group("port"))
+        print("Path:", match.group("path"))
+        print("Query:", match.group("query"))
+
+        # Output:
+        # Full Match: https://example.com:8080/docs?page=2
+        # Scheme: https
+        # Host: example.com
+        # Port: 8080
+        # Path: /docs
+        # Query: page=2
+
+    Parameters:
+    - require_scheme (optional): If False, URLs without 'http://' or 'https://' also match. Defaults to True.
+    - anchored (optional): If True, the URL must be the entire line. Defaults to False.
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `scheme`, `host`, `port`, `path`, and `query`.
+    """
+
+    if not isinstance(require_scheme, bool) or not isinstance(anchored, bool):
+        message = """
+        Method: simply.url(require_scheme, anchored)
+
+        The `require_scheme` and `anchored` arguments must be booleans (True or False).
+        """
+        raise STRlingError(message)
+
+    scheme = merge(group('scheme', any_of('https', 'http')), '://')
+    if not require_scheme:
+        scheme = may(scheme)
+
+    alphanumeric = in_chars(letter(), digit())
+    label = merge(alphanumeric, may(in_chars(alphanumeric, '-')(0, 0), alphanumeric))
+    # Without a scheme, a dot is required so plain words are not mistaken for hosts.
+    host = group('host', label, merge('.', label)(0 if require_scheme else 1, 0))
+    # The host must not stop partway through a label, or it could give up characters to a bad port.
+    host_end = not_ahead(any_of(in_chars(alphanumeric, '-'), merge('.', alphanumeric)))
+
+    port_number = any_of(
+        merge('6553', between(0, 5)),  # 65530-65535
+        merge('655', between(0, 2), digit()),  # 65500-65529
+        merge('65', between(0, 4), digit(2)),  # 65000-65499
+        merge('6', between(0, 4), digit(3)),  # 60000-64999
+        merge(between(1, 5), digit(4)),  # 10000-59999
+        merge(between(1, 9), digit(0, 3)),  # 1-9999
+        '0'
+    )
+    # A port that is out of range or runs into other characters fails the match rather than being cut short.
+    port = merge(may(':', group('port', port_number), not_ahead(word_char())), not_ahead(merge(':', digit())))
+
+    # Each part may contain punctuation, but must not end with it.
+    trailing = '.,;:!?\'")]'
+    path_body = may(not_in_chars(whitespace(), '?#')(0, 0), not_in_chars(whitespace(), '?#', trailing))
+    query_body = may(not_in_chars(whitespace(), '#')(0, 0), not_in_chars(whitespace(), '#', trailing))
+    fragment_body = may(not_whitespace(0, 0), not_in_chars(whitespace(), trailing))
+
+    path = may(group('path', '/', path_body))
+    query = may('?', group('query', query_body))
+    fragment = may('#', fragment_body)
+
+    new_pattern = merge(scheme, host, host_end, port, path, query, fragment)
+    if anchored:
+        new_pattern = merge(start(), new_pattern, end())
+
+    return new_pattern
+
+def ipv4():
+    """
+    Matches an IPv4 address such as '192.168.0.1', where each number is 0 to 255 without leading zeros.
+
+    - The address is not matched when it is part of a longer run of digits and dots, so '256.1.1.1' does not match '56.1.1.1'.
+
+    Example: simply as s
+        match = re.search(str(s.ipv4()), "The server is at 10.0.0.255.")
+
+        print("Full Match:", match.group())
+
+        # Output:
+        # Full Match: 10.0.0.255
+
+    Returns:
+    - Pattern: A Pattern object representing an IPv4 address.
+    """
+
+    octet = any_of(
+        merge('25', between(0, 5)),  # 250-255
+        merge('2', between(0, 4), digit()),  # 200-249
+        merge('1', digit(2)),  # 100-199
+        merge(between(1, 9), digit()),  # 10-99
+        digit()  # 0-9
+    )
+    address = merge(octet, merge('.', octet)(3))
+
+    return merge(
+        not_behind(digit()),
+        not_behind(merge(digit(), '.')),
+        address,
+        not_ahead(digit()),
+        not_ahead(merge('.', digit()))
+    )
+
+def ipv6():
+    """
+    Matches an IPv6 address such as '2001:db8::ff00:42:8329', including '::' compression and IPv4 endings like '::ffff:192.0.2.1'.
+
+    - The address is not matched when it is part of a longer run of hex digits and colons.
+
+    Example: simply as s
+        match = re.search(str(s.ipv6()), "Loopback is ::1 and link local starts with fe80::.")
+
+        print("Full Match:", match.group())
+
+        # Output:
+        # Full Match: ::1
+
+    Returns:
+    - Pattern: A Pattern object representing an IPv6 address.
+    """
+
+    group_part = hex_digit(1, 4)
+    group_colon = merge(group_part, ':')
+    # The final 32 bits are either two groups or an IPv4 address.
+    ending = any_of(merge(group_part, ':', group_part), ipv4())
+
+    # Groups before '::' in each compressed form, e.g. up to 2 groups in 'a:b::c:d:1.2.3.4'.
+    def before(max_groups):
+        if max_groups == 0:
+            return may(group_part)
+        return may(group_colon(0, max_groups), group_part)
+
+    address = any_of(
+        merge(group_colon(6), ending),
+        merge('::', group_colon(5), ending),
+        merge(before(0), '::', group_colon(4), ending),
+        merge(before(1), '::', group_colon(3), ending),
+        merge(before(2), '::', group_colon(2), ending),
+        merge(before(3), '::', group_colon, ending),
+        merge(before(4), '::', ending),
+        merge(before(5), '::', group_part),
+        merge(before(6), '::')
+    )
+
+    return merge(
+        not_behind(any_of(hex_digit(), ':')),
+        address,
+        not_ahead(any_of(hex_digit(), ':'))
+    )
+
+def uuid(*versions, ignore_case: bool = True, anchored: bool = False):
+    """
+    Matches a hyphenated UUID such as '123e4567-e89b-42d3-a456-426614174000'.
+
+    - The version is the first character of the third block, '4' in the example above.
+    - The UUID is not matched when it is part of a longer run of hex digits and hyphens.
+
+    Example: simply as s
+        match = re.search(str(s.uuid(4)), "id=123e4567-e89b-42d3-a456-426614174000")
+
+        print("Full Match:", match.group())
+        print("Version:", match.group("version"))
+
+        # Output:
+        # Full Match: 123e4567-e89b-42d3-a456-426614174000
+        # Version: 4
+
+    Parameters:
+    - *versions (int, optional): One or more allowed versions (1-8). Any version matches if none are given.
+    - ignore_case (optional): If False, only lowercase hex digits match. Defaults to True.
+    - anchored (optional): If True, the UUID must be the entire line. Defaults to False.
+
+    Returns:
+    - Pattern: A Pattern object with the named group `version`.
+    """
+
+    for version in versions:
+        if not isinstance(version, int) or isinstance(version, bool) or not 1 <= version <= 8:
+            message = """
+            Method: simply.uuid(*versions)
+
+            The `versions` must be integers from 1 to 8.
+            """
+            raise STRlingError(message)
+
+    if not isinstance(ignore_case, bool) or not isinstance(anchored, bool):
+        message = """
+        Method: simply.uuid(*versions, ignore_case, anchored)
+
+        The `ignore_case` and `anchored` arguments must be booleans (True or False).
+        """
+        raise STRlingError(message)
+
+    if ignore_case:
+        hex_char = in_chars(between('a', 'f'), between('A', 'F'), digit())
+    else:
+        hex_char = in_chars(between('a', 'f'), digit())
+
+    if versions:
+        version = in_chars(*[str(version) for version in versions])
+    else:
+        version = hex_char
+
+    new_pattern = merge(
+        hex_char(8), '-',
+        hex_char(4), '-',
+        group('version', version), hex_char(3), '-',
+        hex_char(4), '-',
+        hex_char(12)
+    )
+
+    if anchored:
+        return merge(start(), new_pattern, end())
+
+    return merge(
+        not_behind(any_of(hex_char, '-')),
+        new_pattern,
+        not_ahead(any_of(hex_char, '-'))
+    )
+
+def iso_date():
+    """
+    Matches an ISO-8601 calendar date such as '2024-05-31'.
+
+    - The month must be 01 to 12 and the day must be 01 to 31.
+    - The day is not checked against the month, so '2024-02-31' still matches.
+
+    Example: simply as s
+        match = re.search(str(s.iso_date()), "Released on 2024-05-31.")
+
+        print("Year:", match.group("year"))
+        print("Month:", match.group("month"))
+        print("Day:", match.group("day"))
+
+        # Output:
+        # Year: 2024
+        # Month: 05
+        # Day: 31
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `year`, `month`, and `day`.
+    """
+
+    month = any_of(merge('0', between(1, 9)), merge('1', between(0, 2)))
+    day = any_of(merge('0', between(1, 9)), merge(between(1, 2), digit()), merge('3', between(0, 1)))
+
+    return merge(
+        not_behind(digit()),
+        group('year', digit(4)), '-',
+        group('month', month), '-',
+        group('day', day),
+        not_ahead(digit())
+    )
+
+def iso_time():
+    """
+    Matches an ISO-8601 time such as '23:59', '23:59:59', '23:59:59.123', or '23:59:59+05:30'.
+
+    - The hour must be 00 to 23, the minute and second must be 00 to 59.
+    - Seconds, fractional seconds, and the offset are optional.
+    - The offset is either 'Z' or a sign followed by hours and minutes, like '+05:30' or '-0800'.
+
+    Example: simply as s
+        match = re.search(str(s.iso_time()), "Meet at 14:30:00Z")
+
+        print("Hour:", match.group("hour"))
+        print("Minute:", match.group("minute"))
+        print("Second:", match.group("second"))
+        print("Offset:", match.group("offset"))
+
+        # Output:
+        # Hour: 14
+        # Minute: 30
+        # Second: 00
+        # Offset: Z
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `hour`, `minute`, `second`, and `offset`.
+    """
+
+    hour = any_of(merge(between(0, 1), digit()), merge('2', between(0, 3)))
+    minute = merge(between(0, 5), digit())
+    fraction = merge(in_chars('.,'), digit(1, 0))
+    offset = any_of('Z', merge(in_chars('+-'), hour, may(':'), minute))
+
+    return merge(
+        not_behind(digit()),
+        group('hour', hour), ':',
+        group('minute', minute),
+        may(':', group('second', minute), may(fraction)),
+        may(group('offset', offset)),
+        not_ahead(digit())
+    )
+
+def iso_datetime(allow_space: bool = False):
+    """
+    Matches an ISO-8601 date and time such as '2024-05-31T23:59:59.123+05:30'.
+
+    - The date and time follow the same rules as `simply.iso_date()` and `simply.iso_time()`.
+
+    Example: simply as s
+        match = re.search(str(s.iso_datetime()), "Logged 2024-05-31T14:30:00Z")
+
+        print("Full Match:", match.group())
+        print("Day:", match.group("day"))
+        print("Hour:", match.group("hour"))
+
+        # Output:
+        # Full Match: 2024-05-31T14:30:00Z
+        # Day: 31
+        # Hour: 14
+
+    Parameters:
+    - allow_space (optional): If True, a space may separate the date and time instead of 'T'. Defaults to False.
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `year`, `month`, `day`, `hour`, `minute`, `second`, and `offset`.
+    """
+
+    if not isinstance(allow_space, bool):
+        message = """
+        Method: simply.iso_datetime(allow_space)
+
+        The `allow_space` argument must be a boolean (True or False).
+        """
+        raise STRlingError(message)
+
+    separator = in_chars('T ') if allow_space else lit('T')
+
+    return merge(iso_date(), separator, iso_time())
+
+def phone_us():
+    """
+    Matches a US phone number such as '(555) 234-5678', '555.234.5678', '5552345678', or '+1 555-234-5678'.
+
+    - The area code and exchange must start with a digit from 2 to 9.
+    - Parentheses around the area code must be balanced.
+
+    Example: simply as s
+        match = re.search(str(s.phone_us()), "Call +1 (555) 234-5678 today.")
+
+        print("Country:", match.group("country"))
+        print("Area:", match.group("area"))
+        print("Exchange:", match.group("exchange"))
+        print("Line:", match.group("line"))
+
+        # Output:
+        # Country: 1
+        # Area: 555
+        # Exchange: 234
+        # Line: 5678
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `country`, `area`, `exchange`, and `line`.
+    """
+
+    separator = may(in_chars(' .-'))
+    first_three = merge(between(2, 9), digit(2))
+
+    country = may(may('+'), group('country', '1'), separator)
+    # A closing parenthesis is required only when an opening one comes before the area code.
+    area = merge(
+        may('('),
+        group('area', first_three),
+        any_of(
+            merge(behind(merge('(', digit(3))), ')'),
+            not_behind(merge('(', digit(3)))
+        )
+    )
+
+    return merge(
+        not_behind(digit()),
+        country,
+        area, separator,
+        group('exchange', first_three), separator,
+        group('line', digit(4)),
+        not_ahead(digit())
+    )
+
+def phone_e164():
+    """
+    Matches an international phone number in E.164 form such as '+14155552671' or '+44 20 7946 0958'.
+
+    - The number is 8 to 15 digits, starting with a country code, with an optional '+' before it.
+    - With a '+', single spaces, dots, or hyphens may separate the country code and each group of digits after it.
+    - Without a '+', the digits must be one unbroken run like '14155552671'.
+    - A number followed by an extension such as '+14155552671-12' or '+14155552671x12' does not match.
+    - Country codes are assigned so that none is the start of another, which lets the `country` group be told apart.
+
+    Example: simply as s
+        match = re.search(str(s.phone_e164()), "Call +44 20 7946 0958 today.")
+
+        print("Full Match:", match.group())
+        print("Country:", match.group("country"))
+        print("Number:", match.group("number"))
+
+        # Output:
+        # Full Match: +44 20 7946 0958
+        # Country: 44
+        # Number: 20 7946 0958
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `country` and `number`.
+    """
+
+    separator = in_chars(' .-')
+    more_digits = merge(may(separator), digit())
+
+    country_code = any_of(
+        chars('17'),  # 1 and 7
+        merge('2', chars('07')), merge('3', chars('0-469')), merge('4', chars('013-9')),
+        merge('5', chars('1-8')), merge('6', chars('0-6')), merge('8', chars('1246')), merge('9', chars('0-58')),
+        merge('2', chars('1-689'), digit()), merge('3', chars('578'), digit()), merge('42', digit()),
+        merge('5', chars('09'), digit()), merge('6', chars('7-9'), digit()), merge('8', chars('035789'), digit()),
+        merge('9', chars('679'), digit())
+    )
+    # Separators are only allowed after a '+', so dates and dotted numbers aren't mistaken for phone numbers.
+    digit_count = any_of(
+        merge('+', ahead(merge(more_digits(8, 15), not_ahead(more_digits)))),
+        ahead(merge(digit(8, 15), not_ahead(more_digits)))
+    )
+    # The rest of the number is either one unbroken run, or groups that each follow a separator.
+    number_start = any_of(ahead(merge(digit(1, 0), not_ahead(more_digits))), ahead(separator))
+    number = merge(digit(1, 0), merge(separator, digit(1, 0))(0, 0))
+
+    return merge(
+        not_behind(any_of(word_char(), '+')),
+        digit_count,
+        group('country', country_code), number_start, may(separator),
+        group('number', number),
+        not_ahead(any_of(more_digits, word_char()))
+    )
+
+def hex_color(allow_alpha: bool = False):
+    """
+    Matches a hex color such as '#fff' or '#1e90ff', and optionally with transparency such as '#1e90ff80'.
+
+    Example: simply as s
+        match = re.search(str(s.hex_color()), "color: #1E90FF;")
+
+        print("Full Match:", match.group())
+        print("Hex:", match.group("hex"))
+
+        # Output:
+        # Full Match: #1E90FF
+        # Hex: 1E90FF
+
+    Parameters:
+    - allow_alpha (optional): If True, the 8 digit '#RRGGBBAA' form also matches. Defaults to False.
+
+    Returns:
+    - Pattern: A Pattern object with the named group `hex`.
+    """
+
+    if not isinstance(allow_alpha, bool):
+        message = """
+        Method: simply.hex_color(allow_alpha)
+
+        The `allow_alpha` argument must be a boolean (True or False).
+        """
+        raise STRlingError(message)
+
+    if allow_alpha:
+        digits = any_of(hex_digit(8), hex_digit(6), hex_digit(3))
+    else:
+        digits = any_of(hex_digit(6), hex_digit(3))
+
+    return merge('#', group('hex', digits), not_ahead(hex_digit()))
+
+def semver():
+    """
+    Matches a semantic version such as '1.0.0', '2.1.3-beta.2', or '1.0.0-rc.1+build.5' following semver.org.
+
+    - The major, minor, patch, and numeric pre-release identifiers must not have leading zeros.
+    - There is no 'v' prefix, so use `simply.merge(simply.may('v'), simply.semver())` to allow one.
+
+    Example: simply as s
+        match = re.search(str(s.semver()), "Upgrade to 2.1.3-beta.2+exp.sha.5114f85 now.")
+
+        print("Major:", match.group("major"))
+        print("Minor:", match.group("minor"))
+        print("Patch:", match.group("patch"))
+        print("Prerelease:", match.group("prerelease"))
+        print("Build:", match.group("build"))
+
+        # Output:
+        # Major: 2
+        # Minor: 1
+        # Patch: 3
+        # Prerelease: beta.2
+        # Build: exp.sha.5114f85
+
+    Returns:
+    - Pattern: A Pattern object with the named groups `major`, `minor`, `patch`, `prerelease`, and `build`.
+    """
+
+    number = any_of('0', merge(between(1, 9), digit(0, 0)))
+    identifier_char = in_chars(letter(), digit(), '-')
+    # A pre-release identifier is a number without leading zeros or any identifier containing a non-digit.
+    prerelease_identifier = any_of(number, merge(digit(0, 0), in_chars(letter(), '-'), identifier_char(0, 0)))
+
+    return merge(
+        not_behind(any_of(digit(), '.')),
+        group('major', number), '.',
+        group('minor', number), '.',
+        group('patch', number),
+        may('-', group('prerelease', separated_by(prerelease_identifier, '.'))),
+        may('+', group('build', separated_by(identifier_char(1, 0), '.'))),
+        not_ahead(in_chars(letter(), digit(), '-+')),
+        not_ahead(merge('.', identifier_char))
+    )
@@ -1,5 +1,5 @@
 
-from .pattern import STRlingError, Pattern, lit
+from .pattern import STRlingError, Pattern, lit, _escape_char, _has_range
 
 
 
@@ -236,7 +236,7 @@ def in_chars(*patterns):
 
     joined = r''
     for pattern in clean_patterns:
-        if len(str(pattern)) > 1 and str(pattern)[-1] == '}' and str(pattern)[-2] != "\\":
+        if _has_range(str(pattern)):
             message = """
             Method: simply.in_chars(*patterns)
 
@@ -310,7 +310,7 @@ def not_in_chars(*patterns):
 
     joined = r''
     for pattern in clean_patterns:
-        if len(str(pattern)) > 1 and str(pattern)[-1] == '}' and str(pattern)[-2] != "\\":
+        if _has_range(str(pattern)):
             message = """
             Method: simply.not_in_chars(*patterns)
 
@@ -328,3 +328,220 @@ def not_in_chars(*patterns):
 
     new_pattern = f'[^{joined}]'
     return Pattern(new_pattern, custom_set=True, negated=True)
+
+
+def _codepoint_escape(code: int):
+    if code <= 0xFFFF:
+        return f'\\u{code:04x}'
+    return f'\\U{code:08x}'
+
+def codepoint(code: int, min_rep: int = None, max_rep: int = None):
+    """
+    Matches the single character with the provided Unicode codepoint.
+
+    Examples:
+        - Matches the NUL character.
+
+        my_pattern1 = s.codepoint(0x00)
+
+        - Matches the grinning face emoji.
+
+        my_pattern2 = s.codepoint(0x1F600)
+
+    Parameters:
+    - code (int): The Unicode codepoint of the character (0x0 to 0x10FFFF).
+    - min_rep (optional): Specifies the minimum number of characters to match.
+    - max_rep (optional): Specifies the maximum number of characters to match.
+
+    Returns:
+    - Pattern: A Pattern object representing the character.
+    """
+
+    if not isinstance(code, int) or isinstance(code, bool):
+        message = """
+        Method: simply.codepoint(code)
+
+        The `code` argument must be an integer such as 0x41.
+        """
+        raise STRlingError(message)
+
+    if not 0 <= code <= 0x10FFFF:
+        message = """
+        Method: simply.codepoint(code)
+
+        The `code` must be a valid Unicode codepoint (0x0 to 0x10FFFF).
+        """
+        raise STRlingError(message)
+
+    if 0xD800 <= code <= 0xDFFF:
+        message = """
+        Method: simply.codepoint(code)
+
+        The `code` must not be a surrogate codepoint (0xD800 to 0xDFFF).
+        """
+        raise STRlingError(message)
+
+    return Pattern(_codepoint_escape(code))(min_rep, max_rep)
+
+
+def codepoint_between(start: int, end: int, min_rep: int = None, max_rep: int = None):
+    """
+    Matches all characters within and including the start and end of a Unicode codepoint range.
+
+    Examples:
+        - Matches any control character.
+
+        my_pattern1 = s.codepoint_between(0x00, 0x1F)
+
+        - Matches any character past the Basic Multilingual Plane.
+
+        my_pattern2 = s.codepoint_between(0x10000, 0x10FFFF)
+
+    Parameters:
+    - start (int): The starting codepoint of the range.
+    - end (int): The ending codepoint of the range.
+    - min_rep (optional): Specifies the minimum number of characters to match.
+    - max_rep (optional): Specifies the maximum number of characters to match.
+
+    Returns:
+    - Pattern: A Pattern object representing the codepoint range.
+    """
+
+    if not (isinstance(start, int) and isinstance(end, int)) or isinstance(start, bool) or isinstance(end, bool):
+        message = """
+        Method: simply.codepoint_between(start, end)
+
+        The `start` and `end` arguments must both be integers such as 0x41.
+        """
+        raise STRlingError(message)
+
+    if not (0 <= start <= 0x10FFFF and 0 <= end <= 0x10FFFF):
+        message = """
+        Method: simply.codepoint_between(start, end)
+
+        The `start` and `end` must be valid Unicode codepoints (0x0 to 0x10FFFF).
+        """
+        raise STRlingError(message)
+
+    if start > end:
+        message = """
+        Method: simply.codepoint_between(start, end)
+
+        The `start` codepoint must not be greater than the `end` codepoint.
+        """
+        raise STRlingError(message)
+
+    if 0xD800 <= start <= 0xDFFF or 0xD800 <= end <= 0xDFFF:
+        message = """
+        Method: simply.codepoint_between(start, end)
+
+        The `start` and `end` must not be surrogate codepoints (0xD800 to 0xDFFF).
+        """
+        raise STRlingError(message)
+
+    new_pattern = f'[{_codepoint_escape(start)}-{_codepoint_escape(end)}]'
+    return Pattern(new_pattern, custom_set=True)(min_rep, max_rep)
+
+
+def _parse_chars(spec: str, method: str):
+    if not isinstance(spec, str) or not spec:
+        message = f"""
+        Method: {method}
+
+        The `spec` argument must be a non-empty string such as 'a-zA-Z0-9_'.
+        """
+        raise STRlingError(message)
+
+    # Split the spec into (char, offset, escaped) tokens
+    tokens = []
+    i = 0
+    while i < len(spec):
+        if spec[i] == '\\':
+            if i + 1 == len(spec):
+                message = f"""
+                Method: {method}
+
+                The backslash at position {i} has no character after it to escape.
+
+                Use '\\\\' to match a backslash.
+                """
+                raise STRlingError(message)
+            tokens.append((spec[i + 1], i, True))
+            i += 2
+        else:
+            tokens.append((spec[i], i, False))
+            i += 1
+
+    # Join the tokens as literals or ranges, a dash between two characters is a range
+    joined = ''
+    j = 0
+    while j < len(tokens):
+        char, offset, _ = tokens[j]
+        if j + 2 < len(tokens) and tokens[j + 1][0] == '-' and not tokens[j + 1][2]:
+            end_char = tokens[j + 2][0]
+            if char > end_char:
+                message = f"""
+                Method: {method}
+
+                The range '{char}-{end_char}' at position {offset} is reversed.
+
+                Ensure the lesser character is on the left and the greater character is on the right.
+                """
+                raise STRlingError(message)
+            joined += f'{_escape_char(char)}-{_escape_char(end_char)}'
+            j += 3
+        else:
+            joined += _escape_char(char)
+            j += 1
+
+    return joined
+
+def chars(spec: str, min_rep: int = None, max_rep: int = None):
+    """
+    Matches any character described by a compact spec string of characters and ranges.
+
+    - A dash between two characters is a range, such as 'a-z'.
+    - A dash at the start or end is a literal dash.
+    - A backslash escapes the next character, such as '\\-' for a dash or '\\\\' for a backslash.
+
+    Examples:
+        - Matches any word character.
+
+        my_pattern1 = s.chars('a-zA-Z0-9_')
+
+        - Matches any digit, plus, or dash.
+
+        my_pattern2 = s.chars('0-9+-')
+
+    Parameters:
+    - spec (str): The characters and ranges to match.
+    - min_rep (optional): Specifies the minimum number of characters to match.
+    - max_rep (optional): Specifies the maximum number of characters to match.
+
+    Returns:
+    - Pattern: A Pattern object that matches any of the described characters.
+    """
+    joined = _parse_chars(spec, 'simply.chars(spec)')
+    return Pattern(f'[{joined}]', custom_set=True)(min_rep, max_rep)
+
+def not_chars(spec: str, min_rep: int = None, max_rep: int = None):
+    """
+    Matches any character not described by a compact spec string of characters and ranges.
+
+    - The spec follows the same rules as `simply.chars(spec)`.
+
+    Examples:
+        - Matches anything but a word character.
+
+        my_pattern = s.not_chars('a-zA-Z0-9_')
+
+    Parameters:
+    - spec (str): The characters and ranges to avoid.
+    - min_rep (optional): Specifies the minimum number of characters to match.
+    - max_rep (optional): Specifies the maximum number of characters to match.
+
+    Returns:
+    - Pattern: A Pattern object that matches anything but the described characters.
+    """
+    joined = _parse_chars(spec, 'simply.not_chars(spec)')
+    return Pattern(f'[^{joined}]', custom_set=True, negated=True)(min_rep, max_rep)
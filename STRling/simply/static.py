@@ -343,6 +343,29 @@ def not_newline(min_rep: int = None, max_rep: int = None):
     return Pattern(r'.')(min_rep, max_rep)
 
 
+def any_char(min_rep: int = None, max_rep: int = None):
+    """
+    Matches any character, including a newline.
+
+    Unlike `simply.not_newline()`, this does not require the `re.DOTALL` flag to match across lines.
+
+    Parameters: (min_rep/exact_rep, max_rep)
+    - min_rep (optional): Specifies the minimum number of characters to match.
+    - max_rep (optional): Specifies the maximum number of characters to match.
+
+    Special Cases:
+    - If only `min_rep` is specified, it represents the exact number of characters to match.
+    - If `max_rep` is 0, it means there is no upper limit.
+
+    Returns:
+    - An instance of the Pattern class.
+
+    Note: There is no `simply.not_any_char()` function,
+    since it could never match anything.
+    """
+    return Pattern(r'[\s\S]', custom_set=True)(min_rep, max_rep)
+
+
 def tab(min_rep: int = None, max_rep: int = None):
     """
     Matches a tab character.
@@ -455,6 +478,8 @@ def start():
     """
     Matches the start of a line.
 
+    Without the `re.MULTILINE` flag, this only matches at the start of the text.
+
     Parameters: None
     - This method cannot be specified a range.
 
@@ -471,6 +496,8 @@ def end():
     """
     Matches the end of a line.
 
+    Without the `re.MULTILINE` flag, this only matches at the end of the text or before a final newline.
+
     Parameters: None
     - This method cannot be specified a range.
 
@@ -481,3 +508,46 @@ def end():
     to do this, use `simply.not_ahead(simply.end())`.
     """
     return Pattern(r'$')
+
+
+def absolute_start():
+    """
+    Matches the start of the text, even when the `re.MULTILINE` flag is set.
+
+    Parameters: None
+    - This method cannot be specified a range.
+
+    Returns:
+    - An instance of the Pattern class.
+    """
+    return Pattern(r'\A')
+
+
+def absolute_end():
+    """
+    Matches the end of the text, even when the `re.MULTILINE` flag is set.
+
+    Unlike `simply.end()`, this does not match before a trailing newline.
+
+    Parameters: None
+    - This method cannot be specified a range.
+
+    Returns:
+    - An instance of the Pattern class.
+    """
+    return Pattern(r'\Z')
+
+
+def end_before_final_newline():
+    """
+    Matches the end of the text, or just before a newline that ends the text, even when the `re.MULTILINE` flag is set.
+
+    This is how `simply.end()` behaves without the `re.MULTILINE` flag.
+
+    Parameters: None
+    - This method cannot be specified a range.
+
+    Returns:
+    - An instance of the Pattern class.
+    """
+    return Pattern(r'(?=\n?\Z)', composite=True)
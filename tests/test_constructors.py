@@ -0,0 +1,273 @@
+import re
+import unittest
+
+from STRling import simply as s
+
+
+
+############################
+# Constructors
+########
+
+
+class TestGroup(unittest.TestCase):
+    def test_date_by_name(self):
+        date = s.merge(s.group('year', s.digit(4)), '-', s.group('month', s.digit(2)), '-', s.group('day', s.digit(2)))
+        self.assertEqual(str(date), r'(?:(?P<year>\d{4})\-(?P<month>\d{2})\-(?P<day>\d{2}))')
+        self.assertEqual(date.find_groups('Due 2024-05-31.'), {'year': '2024', 'month': '05', 'day': '31'})
+
+    def test_invalid_names(self):
+        for name in ['1bad', 'a-b', '', 'a b']:
+            with self.subTest(name=name):
+                with self.assertRaisesRegex(s.STRlingError, 'is invalid'):
+                    s.group(name, 'x')
+        with self.assertRaisesRegex(s.STRlingError, 'missing a specified name'):
+            s.group(1, 'x')
+
+    def test_duplicate_names(self):
+        with self.assertRaisesRegex(s.STRlingError, 'already used by a group inside it'):
+            s.group('a', s.group('a', 'x'))
+        with self.assertRaisesRegex(s.STRlingError, 'must be unique'):
+            s.group('outer', s.group('a', 'x'), s.group('a', 'y'))
+        with self.assertRaisesRegex(s.STRlingError, 'must be unique'):
+            s.merge(s.group('a', 'x'), s.group('a', 'y'))
+
+    def test_cannot_repeat(self):
+        with self.assertRaises(s.STRlingError):
+            s.group('a', 'x')(1, 2)
+
+
+class TestAtomic(unittest.TestCase):
+    def test_emits_atomic_group(self):
+        self.assertEqual(str(s.atomic(s.letter(1, 0))), '(?>[A-Za-z]{1,})')
+        self.assertEqual(str(s.atomic('a', s.digit())), r'(?>a\d)')
+
+    def test_never_gives_back(self):
+        self.assertIsNone(s.merge(s.atomic(s.letter(1, 0)), 'b').find('aab'))
+        self.assertEqual(s.merge(s.letter(1, 0), 'b').find('aab'), 'aab')
+        self.assertEqual(s.merge(s.atomic(s.letter(1, 0)), '1').find('aa1'), 'aa1')
+
+    def test_duplicate_names(self):
+        with self.assertRaisesRegex(s.STRlingError, 'must be unique'):
+            s.atomic(s.group('a', 'x'), s.group('a', 'y'))
+
+
+class TestSeparatedBy(unittest.TestCase):
+    def test_csv(self):
+        numbers = s.separated_by(s.digit(1, 0), ',').compile()
+        self.assertTrue(numbers.fullmatch('1'))
+        self.assertTrue(numbers.fullmatch('1,22,333'))
+        self.assertFalse(numbers.fullmatch(',1'))
+        self.assertFalse(numbers.fullmatch('1,2,'))
+        self.assertFalse(numbers.fullmatch('1,,2'))
+        self.assertFalse(numbers.fullmatch(''))
+
+    def test_allow_trailing(self):
+        numbers = s.separated_by(s.digit(1, 0), ',', allow_trailing=True).compile()
+        self.assertTrue(numbers.fullmatch('1,2,'))
+        self.assertTrue(numbers.fullmatch('1,2'))
+        self.assertFalse(numbers.fullmatch(',1'))
+        self.assertFalse(numbers.fullmatch('1,,'))
+
+    def test_pattern_separator(self):
+        words = s.separated_by(s.letter(1, 0), s.merge(',', s.whitespace(0, 0)))
+        self.assertEqual(words.find('a, bc,d'), 'a, bc,d')
+
+    def test_emitted(self):
+        self.assertEqual(str(s.separated_by('a', ',')), '(?:a(?:,a){0,})')
+
+    def test_rejects_named_groups(self):
+        with self.assertRaises(s.STRlingError):
+            s.separated_by(s.group('number', s.digit(1, 0)), ',')
+        with self.assertRaises(s.STRlingError):
+            s.separated_by(s.digit(1, 0), s.group('comma', ','))
+
+    def test_rejects_bad_arguments(self):
+        with self.assertRaises(s.STRlingError):
+            s.separated_by(1, ',')
+        with self.assertRaises(s.STRlingError):
+            s.separated_by(s.digit(), ',', allow_trailing='yes')
+
+
+class TestAnchored(unittest.TestCase):
+    def test_emitted(self):
+        self.assertEqual(str(s.anchored(s.digit(3))), r'^\d{3}$')
+        self.assertEqual(str(s.fully_anchored(s.digit(3))), r'\A\d{3}\Z')
+        self.assertEqual(str(s.anchored('a.b')), r'^a\.b$')
+
+    def test_idempotent(self):
+        self.assertEqual(str(s.anchored(s.anchored('a'))), '^a$')
+        self.assertEqual(str(s.fully_anchored(s.fully_anchored('a'))), r'\Aa\Z')
+        self.assertEqual(str(s.anchored(s.merge(s.start(), 'a'))), '(?:^a)$')
+
+    def test_anchors_inside_merge(self):
+        self.assertEqual(str(s.anchored(s.merge(s.start(), 'a', s.end()))), '(?:^a$)')
+        self.assertEqual(str(s.fully_anchored(s.merge(s.absolute_start(), 'a', s.absolute_end()))), r'(?:\Aa\Z)')
+        self.assertEqual(str(s.anchored(s.merge('a', s.end())(2))), '^(?:a$){2}$')
+
+    def test_any_of_gets_new_anchors(self):
+        pattern = s.anchored(s.any_of(s.merge(s.start(), 'a'), 'b'))
+        self.assertEqual(str(pattern), '^(?:(?:^a)|b)$')
+        self.assertTrue(pattern.matches('b'))
+        self.assertFalse(pattern.matches('bb'))
+
+    def test_escaped_anchors_are_literal(self):
+        self.assertEqual(str(s.anchored(s.lit('a$'))), r'^a\$$')
+        self.assertEqual(str(s.anchored(s.lit('^a'))), r'^\^a$')
+        self.assertEqual(str(s.anchored(s.lit('\\'))), r'^\\$')
+        self.assertEqual(str(s.fully_anchored(s.lit('\\Z'))), r'\A\\Z\Z')
+        self.assertEqual(str(s.fully_anchored(s.lit('\\A'))), r'\A\\A\Z')
+        self.assertTrue(s.fully_anchored(s.lit('\\Z')).matches('\\Z'))
+
+    def test_lines_and_text(self):
+        self.assertTrue(s.anchored(s.digit(3)).matches('123\n'))
+        self.assertFalse(s.fully_anchored(s.digit(3)).matches('123\n'))
+        self.assertFalse(s.anchored(s.digit(3)).matches('1234'))
+        self.assertTrue(s.anchored(s.digit(3)).matches('abc\n123', re.MULTILINE))
+        self.assertFalse(s.fully_anchored(s.digit(3)).matches('abc\n123', re.MULTILINE))
+
+    def test_keeps_named_groups(self):
+        pattern = s.anchored(s.group('number', s.digit(3)))
+        self.assertEqual(pattern.find_groups('123'), {'number': '123'})
+        with self.assertRaises(s.STRlingError):
+            s.merge(pattern, s.group('number', s.digit()))
+
+
+class TestTemplate(unittest.TestCase):
+    def test_emitted(self):
+        pattern = s.template('order-{id}', id=s.digit(6))
+        self.assertEqual(str(pattern), r'(?:order\-\d{6})')
+        self.assertTrue(pattern.compile().fullmatch('order-123456'))
+        self.assertFalse(pattern.compile().fullmatch('order-12345'))
+
+    def test_literal_braces(self):
+        pattern = s.template('{{{id}}}', id=s.digit())
+        self.assertEqual(str(pattern), r'(?:\{\d\})')
+        self.assertTrue(pattern.compile().fullmatch('{1}'))
+        self.assertEqual(str(s.template('}}{{')), r'(?:\}\{)')
+
+    def test_adjacent_placeholders(self):
+        pattern = s.template('{a}{b}', a=s.digit(), b=s.letter())
+        self.assertEqual(str(pattern), r'(?:\d[A-Za-z])')
+        self.assertTrue(pattern.compile().fullmatch('1a'))
+
+    def test_reused_placeholder(self):
+        pattern = s.template('{a}-{a}', a=s.digit(2))
+        self.assertEqual(str(pattern), r'(?:\d{2}\-\d{2})')
+
+    def test_string_values_are_literal(self):
+        self.assertEqual(str(s.template('{a}!', a='x.y')), r'(?:x\.y!)')
+
+    def test_missing_name(self):
+        with self.assertRaises(s.STRlingError) as context:
+            s.template('id-{a}')
+        self.assertIn("The placeholder 'a' at position 3 was not provided a pattern.", str(context.exception))
+
+    def test_unused_name(self):
+        with self.assertRaises(s.STRlingError) as context:
+            s.template('id', a=s.digit(), b=s.digit())
+        self.assertIn('The provided patterns have no matching placeholder: a, b.', str(context.exception))
+
+    def test_stray_braces(self):
+        with self.assertRaises(s.STRlingError) as context:
+            s.template('a}b')
+        self.assertIn('The closing brace at position 1 has no opening brace.', str(context.exception))
+        for text in ['{a', '{1}', '{}', 'x{a-b}']:
+            with self.subTest(text=text):
+                with self.assertRaises(s.STRlingError):
+                    s.template(text, a=s.digit())
+
+    def test_rejects_bad_arguments(self):
+        with self.assertRaises(s.STRlingError):
+            s.template(1)
+        with self.assertRaises(s.STRlingError):
+            s.template('{a}', a=1)
+
+
+class TestCaseInsensitive(unittest.TestCase):
+    def test_scoped_to_its_patterns(self):
+        pattern = s.merge(s.case_insensitive('abc'), 'DEF')
+        self.assertEqual(str(pattern), '(?:(?i:abc)DEF)')
+        self.assertTrue(pattern.compile().fullmatch('aBcDEF'))
+        self.assertTrue(pattern.compile().fullmatch('ABCDEF'))
+        self.assertFalse(pattern.compile().fullmatch('abcdef'))
+
+    def test_several_patterns(self):
+        pattern = s.case_insensitive('a', s.digit(), 'b')
+        self.assertEqual(str(pattern), r'(?i:a\db)')
+        self.assertTrue(pattern.compile().fullmatch('A1B'))
+
+    def test_case_sensitive_inside(self):
+        pattern = s.case_insensitive('a', s.case_sensitive('B'), 'c')
+        self.assertEqual(str(pattern), '(?i:a(?-i:B)c)')
+        self.assertTrue(pattern.compile().fullmatch('aBC'))
+        self.assertFalse(pattern.compile().fullmatch('abc'))
+        self.assertTrue(s.case_sensitive('B').compile(re.IGNORECASE).fullmatch('B'))
+        self.assertFalse(s.case_sensitive('B').compile(re.IGNORECASE).fullmatch('b'))
+
+    def test_keeps_named_groups(self):
+        pattern = s.case_insensitive(s.group('word', 'abc'))
+        self.assertEqual(pattern.find_groups('xABC'), {'word': 'ABC'})
+        with self.assertRaises(s.STRlingError):
+            s.case_insensitive(s.group('word', 'a'), s.group('word', 'b'))
+
+    def test_rejects_bad_arguments(self):
+        with self.assertRaises(s.STRlingError):
+            s.case_insensitive(1)
+
+
+
+############################
+# Repetition Modes
+########
+
+
+class TestRepetitionModes(unittest.TestCase):
+    def test_lazy_forms(self):
+        for pattern, expected in {
+            s.lazy(s.digit(3)): r'\d{3}?',
+            s.lazy(s.digit(2, 0)): r'\d{2,}?',
+            s.lazy(s.digit(1, 3)): r'\d{1,3}?',
+            s.lazy(s.may(s.digit())): r'(?:\d)??',
+        }.items():
+            with self.subTest(pattern=expected):
+                self.assertEqual(str(pattern), expected)
+                pattern.compile()
+
+    def test_possessive_forms(self):
+        for pattern, expected in {
+            s.possessive(s.digit(3)): r'\d{3}+',
+            s.possessive(s.digit(2, 0)): r'\d{2,}+',
+            s.possessive(s.digit(1, 3)): r'\d{1,3}+',
+            s.possessive(s.may(s.digit())): r'(?:\d)?+',
+        }.items():
+            with self.subTest(pattern=expected):
+                self.assertEqual(str(pattern), expected)
+                pattern.compile()
+
+    def test_matching(self):
+        self.assertEqual(s.lazy(s.digit(1, 3)).find('123'), '1')
+        self.assertEqual(s.lazy(s.may(s.digit())).find('1'), '')
+        self.assertEqual(s.merge('"', s.lazy(s.not_newline(0, 0)), '"').find('"a" and "b"'), '"a"')
+        self.assertIsNone(s.merge(s.possessive(s.digit(1, 0)), '1').find('111'))
+        self.assertIsNone(s.merge(s.possessive(s.may('a')), 'a').find('a'))
+
+    def test_requires_a_range(self):
+        for build in [lambda: s.lazy(s.digit()), lambda: s.possessive(s.digit()), lambda: s.lazy(s.lit('a?'))]:
+            with self.assertRaisesRegex(s.STRlingError, 'must be invoked with a range'):
+                build()
+
+    def test_one_mode_only(self):
+        for build in [lambda: s.lazy(s.lazy(s.digit(1, 2))), lambda: s.possessive(s.lazy(s.digit(1, 2))), lambda: s.lazy(s.possessive(s.may('a')))]:
+            with self.assertRaisesRegex(s.STRlingError, 'only have one repetition mode'):
+                build()
+
+    def test_modes_cannot_be_ranged_again(self):
+        for build in [lambda: s.may('a')(3), lambda: s.lazy(s.digit(1, 2))(3), lambda: s.possessive(s.may('a'))(1, 2)]:
+            with self.assertRaisesRegex(s.STRlingError, 'Cannot re-invoke'):
+                build()
+
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,193 @@
+import re
+import unittest
+
+from STRling import simply as s
+
+
+
+############################
+# Literals
+########
+
+
+class TestLit(unittest.TestCase):
+    def test_control_characters_are_escaped(self):
+        self.assertEqual(str(s.lit('a\nb')), 'a\\nb')
+        self.assertEqual(len(str(s.lit('a\nb'))), 4)
+        self.assertTrue(s.lit('a\nb').matches('a\nb'))
+
+    def test_escapes(self):
+        for text, expected in {
+            '\x00': '\\x00',
+            '\x7f': '\\x7f',
+            '\x85': '\\x85',
+            '\t\r\f\v': '\\t\\r\\f\\v',
+            '/': '\\/',
+            'a.b*': 'a\\.b\\*',
+        }.items():
+            with self.subTest(text=text):
+                pattern = s.lit(text)
+                self.assertEqual(str(pattern), expected)
+                self.assertTrue(re.fullmatch(str(pattern), text))
+
+    def test_no_raw_control_characters(self):
+        text = ''.join(chr(code) for code in range(0xA0))
+        pattern = s.lit(text)
+        self.assertFalse(any(ord(char) < 0x20 or 0x7f <= ord(char) <= 0x9f for char in str(pattern)))
+        self.assertTrue(re.fullmatch(str(pattern), text))
+
+    def test_sets_escape_the_same_way(self):
+        for text in ['\n', '\x00', '\x85', '/']:
+            with self.subTest(text=text):
+                self.assertEqual(str(s.in_chars(text)), f'[{s.lit(text)}]')
+                self.assertEqual(str(s.chars(text)), f'[{s.lit(text)}]')
+
+
+
+############################
+# Operators
+########
+
+
+class TestOperators(unittest.TestCase):
+    def test_add(self):
+        pattern = s.letter() + s.digit(3) + '!'
+        self.assertEqual(str(pattern), r'(?:(?:[A-Za-z]\d{3})!)')
+        self.assertTrue(pattern.compile().fullmatch('a123!'))
+        self.assertFalse(pattern.compile().fullmatch('a12!'))
+
+    def test_or(self):
+        pattern = s.digit(3) | s.letter(3)
+        self.assertEqual(str(pattern), r'(?:\d{3}|[A-Za-z]{3})')
+        self.assertTrue(pattern.compile().fullmatch('123'))
+        self.assertTrue(pattern.compile().fullmatch('abc'))
+        self.assertFalse(pattern.compile().fullmatch('1a2'))
+
+    def test_str_on_either_side(self):
+        self.assertEqual(str('a' + s.digit()), r'(?:a\d)')
+        self.assertEqual(str(s.digit() + 'a'), r'(?:\da)')
+        self.assertEqual(str('a' | s.digit()), r'(?:a|\d)')
+        self.assertEqual(str(s.digit() | 'a'), r'(?:\d|a)')
+        self.assertEqual(str('.' + s.digit()), r'(?:\.\d)')
+
+    def test_precedence(self):
+        pattern = s.lit('a') + 'b' | s.lit('c')
+        self.assertEqual(str(pattern), '(?:(?:ab)|c)')
+        self.assertTrue(pattern.compile().fullmatch('ab'))
+        self.assertTrue(pattern.compile().fullmatch('c'))
+        self.assertFalse(pattern.compile().fullmatch('ac'))
+        grouped = s.lit('a') + (s.lit('b') | 'c')
+        self.assertEqual(str(grouped), '(?:a(?:b|c))')
+        self.assertTrue(grouped.compile().fullmatch('ac'))
+
+    def test_rejects_other_types(self):
+        for other in [1, None, ['a']]:
+            with self.subTest(other=other):
+                with self.assertRaises(s.STRlingError):
+                    s.digit() + other
+                with self.assertRaises(s.STRlingError):
+                    s.digit() | other
+
+
+
+############################
+# Compiling
+########
+
+
+class TestCompile(unittest.TestCase):
+    def test_compiles(self):
+        compiled = s.digit(3).compile()
+        self.assertIsInstance(compiled, re.Pattern)
+        self.assertEqual(compiled.pattern, r'\d{3}')
+        self.assertEqual(compiled.search('abc123').group(), '123')
+
+    def test_invalid_pattern(self):
+        pattern = s.merge(s.behind(s.digit(1, 3)), 'a')
+        with self.assertRaises(s.STRlingError) as context:
+            pattern.compile()
+        self.assertNotIsInstance(context.exception, re.error)
+        self.assertIn('look-behind requires fixed-width pattern', str(context.exception))
+        self.assertNotIn('3.11', str(context.exception))
+
+    def test_flags(self):
+        pattern = s.lit('abc')
+        self.assertIsNone(pattern.compile().search('ABC'))
+        self.assertEqual(pattern.compile(re.IGNORECASE).search('xABC').group(), 'ABC')
+        self.assertEqual(pattern.compile(re.IGNORECASE).flags & re.IGNORECASE, re.IGNORECASE)
+        lines = s.merge(s.start(), s.letter())
+        self.assertEqual(lines.compile(re.IGNORECASE | re.MULTILINE).findall('a\nB'), ['a', 'B'])
+
+    def test_flags_must_be_int(self):
+        for flags in ['i', 1.5, None, [re.IGNORECASE]]:
+            with self.subTest(flags=flags):
+                with self.assertRaises(s.STRlingError):
+                    s.digit().compile(flags)
+
+
+
+############################
+# Searching
+########
+
+
+class TestSearch(unittest.TestCase):
+    def test_matches(self):
+        self.assertTrue(s.digit(3).matches('abc123'))
+        self.assertFalse(s.digit(3).matches('abc12'))
+        self.assertTrue(s.lit('abc').matches('xABC', re.IGNORECASE))
+
+    def test_find(self):
+        self.assertEqual(s.digit(3).find('abc123def456'), '123')
+        self.assertIsNone(s.digit(3).find('abc'))
+        self.assertEqual(s.digit(0, 0).find('abc'), '')
+        self.assertEqual(s.lit('abc').find('xABC', re.IGNORECASE), 'ABC')
+
+    def test_find_groups(self):
+        pattern = s.merge(s.group('area_code', s.digit(3)), '-', s.group('last_part', s.digit(4)))
+        self.assertEqual(pattern.find_groups('Call 555-1234'), {'area_code': '555', 'last_part': '1234'})
+        self.assertIsNone(pattern.find_groups('Call 555'))
+        self.assertEqual(s.digit(3).find_groups('123'), {})
+        optional = s.merge(s.digit(), s.may(s.group('letter', s.letter())))
+        self.assertEqual(optional.find_groups('1'), {'letter': None})
+
+    def test_compiled_once_per_flags(self):
+        pattern = s.digit(3)
+        self.assertIs(pattern.compile(), pattern.compile())
+        self.assertIs(pattern.compile(re.IGNORECASE), pattern.compile(re.IGNORECASE))
+        self.assertIsNot(pattern.compile(), pattern.compile(re.IGNORECASE))
+        self.assertEqual(pattern.compile(re.IGNORECASE).flags & re.IGNORECASE, re.IGNORECASE)
+        self.assertEqual(pattern.compile().flags & re.IGNORECASE, 0)
+
+    def test_ranged_copy_is_compiled_separately(self):
+        pattern = s.digit()
+        pattern.compile()
+        self.assertEqual(pattern(3).compile().pattern, r'\d{3}')
+        self.assertEqual(pattern.compile().pattern, r'\d')
+
+
+class TestGroupInfo(unittest.TestCase):
+    def test_no_groups(self):
+        self.assertEqual(s.digit(3).capture_count(), 0)
+        self.assertEqual(s.digit(3).group_names(), [])
+        self.assertEqual(s.merge('a', s.any_of('b', 'c')).capture_count(), 0)
+
+    def test_mixed_groups(self):
+        pattern = s.merge(s.capture(s.digit()), s.group('word', s.letter(1, 0)))
+        self.assertEqual(pattern.capture_count(), 2)
+        self.assertEqual(pattern.group_names(), ['', 'word'])
+
+    def test_nested_groups(self):
+        pattern = s.merge(
+            s.group('outer', s.merge(s.capture(s.digit()), s.group('inner', s.letter()))),
+            s.capture(s.digit()),
+        )
+        self.assertEqual(pattern.capture_count(), 4)
+        self.assertEqual(pattern.group_names(), ['outer', '', 'inner', ''])
+        match = pattern.compile().search('1a2')
+        self.assertEqual([match.group(index + 1) for index in range(pattern.capture_count())], ['1a', '1', 'a', '2'])
+
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,417 @@
+import unittest
+
+from STRling import simply as s
+
+
+
+############################
+# Helpers
+########
+
+
+class PrebuiltTestCase(unittest.TestCase):
+    def assert_finds(self, pattern, corpus):
+        # Maps each text to the part of it the pattern should find.
+        for text, expected in corpus.items():
+            with self.subTest(text=text):
+                self.assertEqual(pattern.find(text), expected)
+
+    def assert_rejects(self, pattern, corpus):
+        for text in corpus:
+            with self.subTest(text=text):
+                self.assertIsNone(pattern.find(text))
+
+    def assert_groups(self, pattern, corpus):
+        # Maps each text to the named groups of its first match.
+        for text, expected in corpus.items():
+            with self.subTest(text=text):
+                self.assertEqual(pattern.find_groups(text), expected)
+
+    def assert_snapshots(self, snapshots):
+        # Maps each call to a function building its pattern and the exact regex it must emit,
+        # so any change to the emitted regex is deliberate.
+        for call, (build, expected) in snapshots.items():
+            with self.subTest(call=call):
+                self.assertEqual(str(build()), expected)
+
+
+
+############################
+# Prebuilt Patterns
+########
+
+
+class TestEmail(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.email(), {
+            'jane.doe@example.com': 'jane.doe@example.com',
+            'first.last+tag@mail.example.com': 'first.last+tag@mail.example.com',
+            'a_b-c@sub-domain.example.org': 'a_b-c@sub-domain.example.org',
+            'Email me at jane@example.co.uk.': 'jane@example.co.uk',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.email(), [
+            'jane@example',
+            'jane@example.c',
+            '@example.com',
+            'jane@-example.com',
+            'jane@.com',
+            'jane..doe@example.com',
+            '.jane@example.com',
+            'jane.@example.com',
+            'a@example.com1',
+            'a@example.com-',
+        ])
+
+    def test_long_input(self):
+        # Without the left boundary each 'a' starts a new attempt, which took seconds on this input.
+        self.assertIsNone(s.email().find('a' * 8000 + '@' + 'b.' * 4000))
+
+    def test_groups(self):
+        self.assert_groups(s.email(), {
+            'jane.doe@example.com': {'local': 'jane.doe', 'domain': 'example.com'},
+        })
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.email()': (lambda: s.email(), r'(?:(?<![a-zA-Z0-9_\+\-\.])(?P<local>(?:[a-zA-Z0-9_\+\-]{1,}(?:\.[a-zA-Z0-9_\+\-]{1,}){0,}))@(?P<domain>(?:(?:(?:[A-Za-z\d](?:[A-Za-z\d\-]{0,}[A-Za-z\d])?)\.){1,}[A-Za-z]{2,}))(?!(?:[A-Za-z\d\-]|(?:\.[A-Za-z\d]))))'),
+        })
+
+
+class TestUrl(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.url(), {
+            'https://example.com': 'https://example.com',
+            'http://localhost:3000/a': 'http://localhost:3000/a',
+            'http://1.2.3.4:80/': 'http://1.2.3.4:80/',
+            'http://ex.com:0': 'http://ex.com:0',
+            'http://ex.com:65535/x': 'http://ex.com:65535/x',
+            'http://ex.com/a:1': 'http://ex.com/a:1',
+            'Visit https://example.com.': 'https://example.com',
+            '(see https://example.com/docs)': 'https://example.com/docs',
+            'Visit https://example.com:8080/docs?page=2.': 'https://example.com:8080/docs?page=2',
+            'https://example.com/path?query=1#top': 'https://example.com/path?query=1#top',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.url(), [
+            'example.com',
+            'ftp://example.com',
+            'http://ex.com:65536',
+            'http://ex.com:999999',
+            'http://ex.com:8080abc',
+            'http://ex.com:080',
+        ])
+
+    def test_groups(self):
+        self.assert_groups(s.url(), {
+            'Visit https://example.com:8080/docs?page=2.': {
+                'scheme': 'https', 'host': 'example.com', 'port': '8080', 'path': '/docs', 'query': 'page=2',
+            },
+            'http://example.com': {
+                'scheme': 'http', 'host': 'example.com', 'port': None, 'path': None, 'query': None,
+            },
+        })
+
+    def test_optional_scheme(self):
+        pattern = s.url(require_scheme=False)
+        self.assert_finds(pattern, {
+            'go to example.com/docs now': 'example.com/docs',
+            'example.com:443/x': 'example.com:443/x',
+            'https://example.com': 'https://example.com',
+        })
+        self.assert_rejects(pattern, ['go', 'localhost', 'example.com:99999'])
+
+    def test_anchored(self):
+        pattern = s.url(anchored=True)
+        self.assert_finds(pattern, {'https://example.com/a': 'https://example.com/a'})
+        self.assert_rejects(pattern, ['Visit https://example.com/a'])
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.url()': (lambda: s.url(), '(?:(?:(?P<scheme>(?:https|http)):\\/\\/)(?P<host>(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)(?:\\.(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)){0,})(?!(?:[A-Za-z\\d\\-]|(?:\\.[A-Za-z\\d])))(?:(?::(?P<port>(?:(?:6553[0-5])|(?:655[0-2]\\d)|(?:65[0-4]\\d{2})|(?:6[0-4]\\d{3})|(?:[1-5]\\d{4})|(?:[1-9]\\d{0,3})|0))(?![a-zA-Z0-9_]))?(?!(?::\\d)))(?:(?P<path>\\/(?:[^\\s\\?\\#]{0,}[^\\s\\?\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\?(?P<query>(?:[^\\s\\#]{0,}[^\\s\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\#(?:\\S{0,}[^\\s\\.,;:!\\?\'"\\)\\]])?)?)'),
+            's.url(require_scheme=False)': (lambda: s.url(require_scheme=False), '(?:(?:(?:(?P<scheme>(?:https|http)):\\/\\/))?(?P<host>(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)(?:\\.(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)){1,})(?!(?:[A-Za-z\\d\\-]|(?:\\.[A-Za-z\\d])))(?:(?::(?P<port>(?:(?:6553[0-5])|(?:655[0-2]\\d)|(?:65[0-4]\\d{2})|(?:6[0-4]\\d{3})|(?:[1-5]\\d{4})|(?:[1-9]\\d{0,3})|0))(?![a-zA-Z0-9_]))?(?!(?::\\d)))(?:(?P<path>\\/(?:[^\\s\\?\\#]{0,}[^\\s\\?\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\?(?P<query>(?:[^\\s\\#]{0,}[^\\s\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\#(?:\\S{0,}[^\\s\\.,;:!\\?\'"\\)\\]])?)?)'),
+            's.url(anchored=True)': (lambda: s.url(anchored=True), '(?:^(?:(?:(?P<scheme>(?:https|http)):\\/\\/)(?P<host>(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)(?:\\.(?:[A-Za-z\\d](?:[A-Za-z\\d\\-]{0,}[A-Za-z\\d])?)){0,})(?!(?:[A-Za-z\\d\\-]|(?:\\.[A-Za-z\\d])))(?:(?::(?P<port>(?:(?:6553[0-5])|(?:655[0-2]\\d)|(?:65[0-4]\\d{2})|(?:6[0-4]\\d{3})|(?:[1-5]\\d{4})|(?:[1-9]\\d{0,3})|0))(?![a-zA-Z0-9_]))?(?!(?::\\d)))(?:(?P<path>\\/(?:[^\\s\\?\\#]{0,}[^\\s\\?\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\?(?P<query>(?:[^\\s\\#]{0,}[^\\s\\#\\.,;:!\\?\'"\\)\\]])?))?(?:\\#(?:\\S{0,}[^\\s\\.,;:!\\?\'"\\)\\]])?)?)$)'),
+        })
+
+
+class TestIpv4(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.ipv4(), {
+            '192.168.0.1': '192.168.0.1',
+            '0.0.0.0': '0.0.0.0',
+            '255.255.255.255': '255.255.255.255',
+            'The server is at 10.0.0.255.': '10.0.0.255',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.ipv4(), [
+            '256.1.1.1',
+            '1.2.3',
+            '1.2.3.4.5',
+            '01.2.3.4',
+            '1.2.3.04',
+            '1.2.3.256',
+        ])
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.ipv4()': (lambda: s.ipv4(), r'(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))'),
+        })
+
+
+class TestIpv6(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.ipv6(), {
+            '2001:0db8:85a3:0000:0000:8a2e:0370:7334': '2001:0db8:85a3:0000:0000:8a2e:0370:7334',
+            '2001:db8::ff00:42:8329': '2001:db8::ff00:42:8329',
+            '::1': '::1',
+            '::': '::',
+            'fe80::': 'fe80::',
+            '1::': '1::',
+            '1:2:3:4:5:6:7::': '1:2:3:4:5:6:7::',
+            '::2:3:4:5:6:7:8': '::2:3:4:5:6:7:8',
+            '::ffff:192.0.2.1': '::ffff:192.0.2.1',
+            '1:2:3:4:5:6:1.2.3.4': '1:2:3:4:5:6:1.2.3.4',
+            'Loopback is ::1 and link local starts with fe80::.': '::1',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.ipv6(), [
+            '1:2:3:4:5:6:7:8:9',
+            '1:2:3:4:5:6:7',
+            '12345::1',
+            '1::2::3',
+            '1:2:3:4:5:6:7:8::',
+        ])
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.ipv6()': (lambda: s.ipv6(), r'(?:(?<!(?:[A-Fa-f\d]|:))(?:(?:(?:[A-Fa-f\d]{1,4}:){6}(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:::(?:[A-Fa-f\d]{1,4}:){5}(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:[A-Fa-f\d]{1,4})?::(?:[A-Fa-f\d]{1,4}:){4}(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,1}[A-Fa-f\d]{1,4})?::(?:[A-Fa-f\d]{1,4}:){3}(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,2}[A-Fa-f\d]{1,4})?::(?:[A-Fa-f\d]{1,4}:){2}(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,3}[A-Fa-f\d]{1,4})?::(?:[A-Fa-f\d]{1,4}:)(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,4}[A-Fa-f\d]{1,4})?::(?:(?:[A-Fa-f\d]{1,4}:[A-Fa-f\d]{1,4})|(?:(?<!\d)(?<!(?:\d\.))(?:(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)(?:\.(?:(?:25[0-5])|(?:2[0-4]\d)|(?:1\d{2})|(?:[1-9]\d)|\d)){3})(?!\d)(?!(?:\.\d)))))|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,5}[A-Fa-f\d]{1,4})?::[A-Fa-f\d]{1,4})|(?:(?:(?:[A-Fa-f\d]{1,4}:){0,6}[A-Fa-f\d]{1,4})?::))(?!(?:[A-Fa-f\d]|:)))'),
+        })
+
+
+class TestUuid(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.uuid(), {
+            '123e4567-e89b-42d3-a456-426614174000': '123e4567-e89b-42d3-a456-426614174000',
+            'id=123E4567-E89B-12D3-A456-426614174000;': '123E4567-E89B-12D3-A456-426614174000',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.uuid(), [
+            '123e4567-e89b-42d3-a456-42661417400',
+            '123e4567e89b42d3a456426614174000',
+            '0123e4567-e89b-42d3-a456-426614174000',
+            '123e4567-e89b-42d3-a456-426614174000-1',
+            '123g4567-e89b-42d3-a456-426614174000',
+        ])
+
+    def test_options(self):
+        self.assert_groups(s.uuid(4), {
+            'id=123e4567-e89b-42d3-a456-426614174000': {'version': '4'},
+        })
+        self.assert_rejects(s.uuid(4), ['123e4567-e89b-12d3-a456-426614174000'])
+        self.assert_rejects(s.uuid(ignore_case=False), ['123E4567-E89B-42D3-A456-426614174000'])
+        self.assert_rejects(s.uuid(anchored=True), ['id=123e4567-e89b-42d3-a456-426614174000'])
+
+    def test_invalid_arguments(self):
+        for versions in [(0,), (9,), (True,), ('4',)]:
+            with self.subTest(versions=versions):
+                with self.assertRaises(s.STRlingError):
+                    s.uuid(*versions)
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.uuid()': (lambda: s.uuid(), r'(?:(?<!(?:[a-fA-F\d]|\-))(?:[a-fA-F\d]{8}\-[a-fA-F\d]{4}\-(?P<version>[a-fA-F\d])[a-fA-F\d]{3}\-[a-fA-F\d]{4}\-[a-fA-F\d]{12})(?!(?:[a-fA-F\d]|\-)))'),
+            's.uuid(4, ignore_case=False)': (lambda: s.uuid(4, ignore_case=False), r'(?:(?<!(?:[a-f\d]|\-))(?:[a-f\d]{8}\-[a-f\d]{4}\-(?P<version>[4])[a-f\d]{3}\-[a-f\d]{4}\-[a-f\d]{12})(?!(?:[a-f\d]|\-)))'),
+            's.uuid(anchored=True)': (lambda: s.uuid(anchored=True), r'(?:^(?:[a-fA-F\d]{8}\-[a-fA-F\d]{4}\-(?P<version>[a-fA-F\d])[a-fA-F\d]{3}\-[a-fA-F\d]{4}\-[a-fA-F\d]{12})$)'),
+        })
+
+
+class TestIsoDateTime(PrebuiltTestCase):
+    def test_date(self):
+        self.assert_groups(s.iso_date(), {
+            'Released on 2024-05-31.': {'year': '2024', 'month': '05', 'day': '31'},
+            '2024-02-31': {'year': '2024', 'month': '02', 'day': '31'},
+        })
+        self.assert_rejects(s.iso_date(), ['2024-13-01', '2024-00-10', '2024-05-32', '2024-05-00', '12024-05-31', '2024-05-310'])
+
+    def test_time(self):
+        self.assert_finds(s.iso_time(), {
+            '23:59': '23:59',
+            '23:59:59': '23:59:59',
+            '23:59:59.123': '23:59:59.123',
+            '23:59:59+05:30': '23:59:59+05:30',
+            '08:00-0800': '08:00-0800',
+            'Meet at 14:30:00Z': '14:30:00Z',
+        })
+        self.assert_rejects(s.iso_time(), ['24:00', '23:60', '123:00'])
+
+    def test_datetime(self):
+        self.assert_finds(s.iso_datetime(), {
+            'Logged 2024-05-31T14:30:00Z': '2024-05-31T14:30:00Z',
+            '2024-05-31T23:59:59.123+05:30': '2024-05-31T23:59:59.123+05:30',
+        })
+        self.assert_rejects(s.iso_datetime(), ['2024-05-31 14:30'])
+        self.assert_finds(s.iso_datetime(allow_space=True), {'2024-05-31 14:30': '2024-05-31 14:30'})
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.iso_date()': (lambda: s.iso_date(), r'(?:(?<!\d)(?P<year>\d{4})\-(?P<month>(?:(?:0[1-9])|(?:1[0-2])))\-(?P<day>(?:(?:0[1-9])|(?:[1-2]\d)|(?:3[0-1])))(?!\d))'),
+            's.iso_time()': (lambda: s.iso_time(), r'(?:(?<!\d)(?P<hour>(?:(?:[0-1]\d)|(?:2[0-3]))):(?P<minute>(?:[0-5]\d))(?::(?P<second>(?:[0-5]\d))(?:(?:[\.,]\d{1,}))?)?(?:(?P<offset>(?:Z|(?:[\+\-](?:(?:[0-1]\d)|(?:2[0-3]))(?::)?(?:[0-5]\d)))))?(?!\d))'),
+            's.iso_datetime()': (lambda: s.iso_datetime(), r'(?:(?:(?<!\d)(?P<year>\d{4})\-(?P<month>(?:(?:0[1-9])|(?:1[0-2])))\-(?P<day>(?:(?:0[1-9])|(?:[1-2]\d)|(?:3[0-1])))(?!\d))T(?:(?<!\d)(?P<hour>(?:(?:[0-1]\d)|(?:2[0-3]))):(?P<minute>(?:[0-5]\d))(?::(?P<second>(?:[0-5]\d))(?:(?:[\.,]\d{1,}))?)?(?:(?P<offset>(?:Z|(?:[\+\-](?:(?:[0-1]\d)|(?:2[0-3]))(?::)?(?:[0-5]\d)))))?(?!\d)))'),
+            's.iso_datetime(allow_space=True)': (lambda: s.iso_datetime(allow_space=True), r'(?:(?:(?<!\d)(?P<year>\d{4})\-(?P<month>(?:(?:0[1-9])|(?:1[0-2])))\-(?P<day>(?:(?:0[1-9])|(?:[1-2]\d)|(?:3[0-1])))(?!\d))[T\ ](?:(?<!\d)(?P<hour>(?:(?:[0-1]\d)|(?:2[0-3]))):(?P<minute>(?:[0-5]\d))(?::(?P<second>(?:[0-5]\d))(?:(?:[\.,]\d{1,}))?)?(?:(?P<offset>(?:Z|(?:[\+\-](?:(?:[0-1]\d)|(?:2[0-3]))(?::)?(?:[0-5]\d)))))?(?!\d)))'),
+        })
+
+
+class TestPhoneUs(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.phone_us(), {
+            '(555) 234-5678': '(555) 234-5678',
+            '555.234.5678': '555.234.5678',
+            '5552345678': '5552345678',
+            '+1 555-234-5678': '+1 555-234-5678',
+            '1-555-234-5678': '1-555-234-5678',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.phone_us(), [
+            '155-234-5678',
+            '555-123-5678',
+            '(555 234-5678',
+            '555) 234-5678',
+            '555-234-56789',
+            '555-234-567',
+            '555-ABC-5678',
+            '555-234-56789-0',
+        ])
+
+    def test_groups(self):
+        self.assert_groups(s.phone_us(), {
+            'Call +1 (555) 234-5678 today.': {'country': '1', 'area': '555', 'exchange': '234', 'line': '5678'},
+            '555.234.5678': {'country': None, 'area': '555', 'exchange': '234', 'line': '5678'},
+        })
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.phone_us()': (lambda: s.phone_us(), r'(?:(?<!\d)(?:(?:\+)?(?P<country>1)(?:[\ \.\-])?)?(?:(?:\()?(?P<area>(?:[2-9]\d{2}))(?:(?:(?<=(?:\(\d{3}))\))|(?<!(?:\(\d{3}))))(?:[\ \.\-])?(?P<exchange>(?:[2-9]\d{2}))(?:[\ \.\-])?(?P<line>\d{4})(?!\d))'),
+        })
+
+
+class TestPhoneE164(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.phone_e164(), {
+            '+14155552671': '+14155552671',
+            '14155552671': '14155552671',
+            '+12345678': '+12345678',
+            '+44 20 7946 0958': '+44 20 7946 0958',
+            '+1-415-555-2671': '+1-415-555-2671',
+            '+33 1 23 45 67 89': '+33 1 23 45 67 89',
+            '+380 44 123 4567': '+380 44 123 4567',
+            'Call +44 20 7946 0958 today.': '+44 20 7946 0958',
+            '(+1 415 555 2671)': '+1 415 555 2671',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.phone_e164(), [
+            '2024-05-31 12:30',
+            '1.2.3.4.5.6.7.8',
+            '192.168.0.1',
+            '+14155552671-12',
+            '14155552671-12',
+            '+14155552671x12',
+            '+1 415 555 2671 12 34 56',
+            '1 415 555 2671',
+            '+1234567',
+            '+1234567890123456',
+            '+04155552671',
+            '++14155552671',
+            '+1  415 555 2671',
+            '+1 415 555 CALL',
+            'abc14155552671',
+        ])
+
+    def test_groups(self):
+        self.assert_groups(s.phone_e164(), {
+            'Call +44 20 7946 0958 today.': {'country': '44', 'number': '20 7946 0958'},
+            '+14155552671': {'country': '1', 'number': '4155552671'},
+            '+998901234567': {'country': '998', 'number': '901234567'},
+        })
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.phone_e164()': (lambda: s.phone_e164(), r'(?:(?<!(?:[a-zA-Z0-9_]|\+))(?:(?:\+(?=(?:(?:(?:[\ \.\-])?\d){8,15}(?!(?:(?:[\ \.\-])?\d)))))|(?=(?:\d{8,15}(?!(?:(?:[\ \.\-])?\d)))))(?P<country>(?:[17]|(?:2[07])|(?:3[0-469])|(?:4[013-9])|(?:5[1-8])|(?:6[0-6])|(?:8[1246])|(?:9[0-58])|(?:2[1-689]\d)|(?:3[578]\d)|(?:42\d)|(?:5[09]\d)|(?:6[7-9]\d)|(?:8[035789]\d)|(?:9[679]\d)))(?:(?=(?:\d{1,}(?!(?:(?:[\ \.\-])?\d))))|(?=[\ \.\-]))(?:[\ \.\-])?(?P<number>(?:\d{1,}(?:[\ \.\-]\d{1,}){0,}))(?!(?:(?:(?:[\ \.\-])?\d)|[a-zA-Z0-9_])))'),
+        })
+
+
+class TestHexColor(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.hex_color(), {
+            '#fff': '#fff',
+            'color: #1E90FF;': '#1E90FF',
+        })
+        self.assert_finds(s.hex_color(allow_alpha=True), {
+            '#1e90ff80': '#1e90ff80',
+            '#1e90ff': '#1e90ff',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.hex_color(), ['#ff', '#ffff', '#1e90ff80', '#ggg', 'fff'])
+        self.assert_rejects(s.hex_color(allow_alpha=True), ['#1e90ff8', '#1e90ff800'])
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.hex_color()': (lambda: s.hex_color(), r'(?:\#(?P<hex>(?:[A-Fa-f\d]{6}|[A-Fa-f\d]{3}))(?![A-Fa-f\d]))'),
+            's.hex_color(allow_alpha=True)': (lambda: s.hex_color(allow_alpha=True), r'(?:\#(?P<hex>(?:[A-Fa-f\d]{8}|[A-Fa-f\d]{6}|[A-Fa-f\d]{3}))(?![A-Fa-f\d]))'),
+        })
+
+
+class TestSemver(PrebuiltTestCase):
+    def test_matches(self):
+        self.assert_finds(s.semver(), {
+            '0.0.0': '0.0.0',
+            '1.0.0': '1.0.0',
+            '2.1.3-beta.2': '2.1.3-beta.2',
+            '1.0.0-rc.1+build.5': '1.0.0-rc.1+build.5',
+            '1.0.0-0A.is.legal': '1.0.0-0A.is.legal',
+            '1.0.0+0001': '1.0.0+0001',
+            'Version 10.20.30.': '10.20.30',
+        })
+
+    def test_rejects(self):
+        self.assert_rejects(s.semver(), [
+            '1.0',
+            '01.0.0',
+            '1.00.0',
+            '1.0.00',
+            '1.0.0-01',
+            '1.0.0-',
+            '1.0.0+',
+            '1.0.0.0',
+        ])
+
+    def test_rejects_whole_text(self):
+        # A search can still find a valid version at the start of these, but they aren't versions themselves.
+        self.assert_rejects(s.fully_anchored(s.semver()), [
+            '1.0.0-beta..1',
+            '1.2.3-beta_1',
+            '1.0.0-rc.1+',
+        ])
+
+    def test_groups(self):
+        self.assert_groups(s.semver(), {
+            'Upgrade to 2.1.3-beta.2+exp.sha.5114f85 now.': {
+                'major': '2', 'minor': '1', 'patch': '3', 'prerelease': 'beta.2', 'build': 'exp.sha.5114f85',
+            },
+        })
+
+    def test_snapshots(self):
+        self.assert_snapshots({
+            's.semver()': (lambda: s.semver(), r'(?:(?<!(?:\d|\.))(?P<major>(?:0|(?:[1-9]\d{0,})))\.(?P<minor>(?:0|(?:[1-9]\d{0,})))\.(?P<patch>(?:0|(?:[1-9]\d{0,})))(?:\-(?P<prerelease>(?:(?:(?:0|(?:[1-9]\d{0,}))|(?:\d{0,}[A-Za-z\-][A-Za-z\d\-]{0,}))(?:\.(?:(?:0|(?:[1-9]\d{0,}))|(?:\d{0,}[A-Za-z\-][A-Za-z\d\-]{0,}))){0,})))?(?:\+(?P<build>(?:[A-Za-z\d\-]{1,}(?:\.[A-Za-z\d\-]{1,}){0,})))?(?![A-Za-z\d\-\+])(?!(?:\.[A-Za-z\d\-])))'),
+        })
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,104 @@
+import unittest
+
+from STRling import simply as s
+
+
+
+############################
+# Codepoints
+########
+
+
+class TestCodepoint(unittest.TestCase):
+    def test_codepoint(self):
+        for code, char in {0x00: '\x00', 0x7F: '\x7f', 0x1F600: '\U0001F600'}.items():
+            with self.subTest(code=hex(code)):
+                pattern = s.codepoint(code)
+                self.assertTrue(pattern.matches(char))
+                self.assertFalse(pattern.matches('a'))
+        self.assertEqual(str(s.codepoint(0x00)), r'\u0000')
+        self.assertEqual(str(s.codepoint(0x1F600)), r'\U0001f600')
+
+    def test_codepoint_between(self):
+        pattern = s.codepoint_between(0xFFF0, 0x10010)
+        self.assertEqual(str(pattern), r'[\ufff0-\U00010010]')
+        for char in ['\ufff0', '\uffff', '\U00010000', '\U00010010']:
+            with self.subTest(char=char):
+                self.assertTrue(pattern.matches(char))
+        self.assertFalse(pattern.matches('\uffef'))
+        self.assertFalse(pattern.matches('\U00010011'))
+
+    def test_ranges_around_surrogates(self):
+        self.assertTrue(s.codepoint_between(0x80, 0x10FFFF).matches('\U0010FFFF'))
+        self.assertTrue(s.codepoint_between(0, 0x10FFFF).matches('\x00'))
+        self.assertTrue(s.codepoint_between(0xD000, 0xE000).matches('\ud7ff'))
+        self.assertTrue(s.codepoint_between(0xD000, 0xE000).matches('\ue000'))
+
+    def test_rejected(self):
+        for code in [True, 0xD800, 0xDFFF, -1, 0x110000, 0.5]:
+            with self.subTest(code=code):
+                with self.assertRaises(s.STRlingError):
+                    s.codepoint(code)
+        for start, end in [(False, 0x41), (0x41, 0xD800), (0xDFFF, 0xE000), (0x42, 0x41)]:
+            with self.subTest(start=start, end=end):
+                with self.assertRaises(s.STRlingError):
+                    s.codepoint_between(start, end)
+
+
+
+############################
+# Character Specs
+########
+
+
+class TestChars(unittest.TestCase):
+    def assert_set(self, spec, expected, matched, unmatched):
+        pattern = s.chars(spec)
+        self.assertEqual(str(pattern), expected)
+        for char in matched:
+            with self.subTest(spec=spec, char=char):
+                self.assertTrue(pattern.compile().fullmatch(char))
+        for char in unmatched:
+            with self.subTest(spec=spec, char=char):
+                self.assertFalse(pattern.compile().fullmatch(char))
+
+    def test_dashes(self):
+        self.assert_set('-az', r'[\-az]', '-az', 'b')
+        self.assert_set('az-', r'[az\-]', '-az', 'b')
+        self.assert_set('a-b-c', r'[a-b\-c]', 'abc-', 'd')
+        self.assert_set('a\\-z', r'[a\-z]', 'a-z', 'b')
+
+    def test_escapes(self):
+        self.assert_set('\\-', r'[\-]', '-', '\\')
+        self.assert_set('\\\\', r'[\\]', '\\', '-')
+        self.assert_set('/', r'[\/]', '/', '\\')
+        self.assert_set(']^', r'[\]\^]', ']^', '\\')
+
+    def test_multi_byte_ranges(self):
+        self.assert_set('á-é', '[á-é]', 'áâé', 'aê')
+        self.assert_set('一-鿿', '[一-鿿]', '一中鿿', 'a')
+        self.assert_set('\U0001F600-\U0001F64F', '[\U0001F600-\U0001F64F]', '\U0001F600\U0001F64F', '\U0001F650')
+
+    def test_not_chars(self):
+        pattern = s.not_chars('a-c-')
+        self.assertEqual(str(pattern), r'[^a-c\-]')
+        self.assertTrue(pattern.matches('d'))
+        self.assertFalse(pattern.matches('b-'))
+
+    def test_dangling_backslash(self):
+        with self.assertRaises(s.STRlingError) as context:
+            s.chars('a\\')
+        self.assertIn('The backslash at position 1 has no character after it to escape.', str(context.exception))
+
+    def test_reversed_range(self):
+        with self.assertRaises(s.STRlingError) as context:
+            s.chars('z-a')
+        self.assertIn("The range 'z-a' at position 0 is reversed.", str(context.exception))
+        with self.assertRaises(s.STRlingError) as context:
+            s.chars('abz-a')
+        self.assertIn("The range 'z-a' at position 2 is reversed.", str(context.exception))
+
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,63 @@
+import re
+import unittest
+
+from STRling import simply as s
+
+
+
+############################
+# Character Sets
+########
+
+
+class TestAnyChar(unittest.TestCase):
+    def test_matches_newline(self):
+        self.assertEqual(str(s.any_char()), r'[\s\S]')
+        self.assertEqual(s.any_char(3).find('a\nb'), 'a\nb')
+        self.assertTrue(s.merge('a', s.any_char(), 'b').matches('a\nb'))
+
+    def test_in_sets_and_ranges(self):
+        self.assertEqual(s.merge('"', s.any_char(0, 0), '"').find('"a\n"'), '"a\n"')
+        self.assertTrue(s.in_chars(s.any_char()).matches('\n'))
+
+
+
+############################
+# Anchors
+########
+
+
+class TestAnchors(unittest.TestCase):
+    def test_emitted(self):
+        self.assertEqual(str(s.absolute_start()), r'\A')
+        self.assertEqual(str(s.absolute_end()), r'\Z')
+        self.assertEqual(str(s.end_before_final_newline()), r'(?=\n?\Z)')
+
+    def test_multiline(self):
+        text = 'ab\ncd\n'
+        self.assertEqual(s.merge(s.start(), s.letter()).compile(re.MULTILINE).findall(text), ['a', 'c'])
+        self.assertEqual(s.merge(s.absolute_start(), s.letter()).compile(re.MULTILINE).findall(text), ['a'])
+        self.assertEqual(s.merge(s.letter(), s.end()).compile(re.MULTILINE).findall(text), ['b', 'd'])
+        self.assertEqual(s.merge(s.letter(), s.absolute_end()).compile(re.MULTILINE).findall(text), [])
+        self.assertEqual(s.merge(s.letter(), s.end_before_final_newline()).compile(re.MULTILINE).findall(text), ['d'])
+        self.assertEqual(s.merge(s.letter(), s.absolute_end()).compile(re.MULTILINE).findall('ab\ncd'), ['d'])
+
+    def test_final_newline(self):
+        pattern = s.merge('a', s.end_before_final_newline())
+        self.assertTrue(pattern.compile(re.MULTILINE).search('a\n'))
+        self.assertTrue(pattern.compile(re.MULTILINE).search('a'))
+        self.assertFalse(pattern.compile(re.MULTILINE).search('a\n\n'))
+        self.assertFalse(s.merge('a', s.absolute_end()).compile(re.MULTILINE).search('a\n'))
+
+    def test_inside_lookarounds(self):
+        last = s.merge(s.letter(), s.ahead(s.absolute_end()))
+        self.assertEqual(last.compile(re.MULTILINE).findall('ab\ncd'), ['d'])
+        first = s.merge(s.behind(s.absolute_start()), s.letter())
+        self.assertEqual(first.compile(re.MULTILINE).findall('ab\ncd'), ['a'])
+        not_first = s.merge(s.not_behind(s.absolute_start()), s.letter())
+        self.assertEqual(not_first.compile(re.MULTILINE).findall('ab\ncd'), ['b', 'c', 'd'])
+
+
+
+if __name__ == '__main__':
+    unittest.main()